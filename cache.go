@@ -0,0 +1,71 @@
+package sleeper
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// NewClientWithPlayerCache creates a new Sleeper Client with a GraphQL token, loading every NFL player from the file
+// at cachePath if it was written within maxAge. Otherwise the players are fetched and the file is rewritten if
+// possible. Sleeper asks that the players endpoint is called no more than once a day, so a maxAge of 24 hours is a
+// good default.
+func NewClientWithPlayerCache(graphqlToken string, cachePath string, maxAge time.Duration) (Client, error) {
+	c := newClient(graphqlToken)
+	if players, err := readPlayerCache(cachePath, maxAge); err == nil {
+		c.NFLPlayers = players
+		return c, nil
+	}
+
+	players, err := c.GetAllPlayers()
+	if err != nil {
+		return c, err
+	}
+	c.NFLPlayers = players
+	// the cache only saves a refetch next time, so a client with fresh players is still good if it can't be written
+	_ = writePlayerCache(cachePath, players)
+	return c, nil
+}
+
+func readPlayerCache(path string, maxAge time.Duration) (AllPlayersJSON, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if time.Since(info.ModTime()) > maxAge {
+		return nil, os.ErrNotExist
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	players := AllPlayersJSON{}
+	if err := json.NewDecoder(f).Decode(&players); err != nil {
+		return nil, err
+	}
+	return players, nil
+}
+
+// writePlayerCache writes to a temp file first so a half-written cache is never read.
+func writePlayerCache(path string, players AllPlayersJSON) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	if err := json.NewEncoder(f).Encode(players); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
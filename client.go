@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -26,13 +27,7 @@ func NewClient() (Client, error) {
 
 // NewClientWithToken creates a new Sleeper Client with a GraphQL token.
 func NewClientWithToken(graphqlToken string) (Client, error) {
-	c := Client{
-		httpClient: &http.Client{
-			Timeout: time.Minute,
-		},
-		sleeperURL:   sleeperBaseURL,
-		graphqlToken: graphqlToken,
-	}
+	c := newClient(graphqlToken)
 	players, err := c.GetAllPlayers()
 	if err != nil {
 		return c, err
@@ -41,6 +36,16 @@ func NewClientWithToken(graphqlToken string) (Client, error) {
 	return c, nil
 }
 
+func newClient(graphqlToken string) Client {
+	return Client{
+		httpClient: &http.Client{
+			Timeout: time.Minute,
+		},
+		sleeperURL:   sleeperBaseURL,
+		graphqlToken: graphqlToken,
+	}
+}
+
 func (c *Client) sendRequest(path string, v interface{}) error {
 	req, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.sleeperURL, path), nil)
 	if err != nil {
@@ -96,6 +101,27 @@ func (c Client) GetAllPlayers() (AllPlayersJSON, error) {
 	return res, err
 }
 
+// PlayerName returns the given player's name, falling back to their ID if they aren't in the players list.
+func (c Client) PlayerName(playerID string) string {
+	return playerName(c.NFLPlayers, playerID)
+}
+
+func playerName(players AllPlayersJSON, playerID string) string {
+	if name := fullName(players[playerID]); name != "" {
+		return name
+	}
+	return playerID
+}
+
+// fullName returns the player's full name, or an empty string if Sleeper doesn't have one.
+func fullName(info PlayerInfoJSON) string {
+	if info.FullName != "" {
+		return info.FullName
+	}
+	// team defenses don't have a full name
+	return strings.TrimSpace(info.FirstName + " " + info.LastName)
+}
+
 // GetTrendingPlayers fetches the currently trending NFL players on Sleeper.
 func (c Client) GetTrendingPlayers(trendType TrendingPlayerType) (TrendingPlayersJSON, error) {
 	res := TrendingPlayersJSON{}
@@ -110,6 +136,20 @@ func (c Client) GetNflStatus() (SportStatusJSON, error) {
 	return res, err
 }
 
+// GetUser returns the user with the given username or user ID.
+func (c Client) GetUser(usernameOrID string) (UserInfoJSON, error) {
+	res := UserInfoJSON{}
+	err := c.sendRequest(fmt.Sprintf("/user/%s", usernameOrID), &res)
+	return res, err
+}
+
+// GetUserLeagues returns the NFL leagues the given user is in for a season.
+func (c Client) GetUserLeagues(userID string, season string) (LeaguesJSON, error) {
+	res := LeaguesJSON{}
+	err := c.sendRequest(fmt.Sprintf("/user/%s/leagues/nfl/%s", userID, season), &res)
+	return res, err
+}
+
 // GetLeagueInfo returns info about the provided league.
 func (c Client) GetLeagueInfo(leagueID string) (LeagueInfoJSON, error) {
 	res := LeagueInfoJSON{}
@@ -138,6 +178,27 @@ func (c Client) GetLeagueMatchups(leagueID string, week int) (MatchupsJSON, erro
 	return res, err
 }
 
+// GetLeagueTransactions returns the transactions for the provided league and week.
+func (c Client) GetLeagueTransactions(leagueID string, week int) (TransactionsJSON, error) {
+	res := TransactionsJSON{}
+	err := c.sendRequest(fmt.Sprintf("/league/%s/transactions/%d", leagueID, week), &res)
+	return res, err
+}
+
+// GetLeagueWinnersBracket returns the playoff bracket for the provided league.
+func (c Client) GetLeagueWinnersBracket(leagueID string) (BracketJSON, error) {
+	res := BracketJSON{}
+	err := c.sendRequest(fmt.Sprintf("/league/%s/winners_bracket", leagueID), &res)
+	return res, err
+}
+
+// GetLeagueLosersBracket returns the consolation bracket for the provided league.
+func (c Client) GetLeagueLosersBracket(leagueID string) (BracketJSON, error) {
+	res := BracketJSON{}
+	err := c.sendRequest(fmt.Sprintf("/league/%s/losers_bracket", leagueID), &res)
+	return res, err
+}
+
 // GetBatchScores returns scores and game info for the given week's games.
 func (c Client) GetBatchScores(week int, season string) (BatchScoresJSON, error) {
 	res := BatchScoresJSON{}
@@ -165,3 +226,10 @@ func (c Client) GetPlayerStats(playerIds []string, week int, season string) (Pla
 	err = c.sendGraphqlRequest(op, &res)
 	return res, err
 }
+
+// GetDraftPicks returns every pick made so far in the given draft.
+func (c Client) GetDraftPicks(draftID string) (DraftPicksJSON, error) {
+	res := DraftPicksJSON{}
+	err := c.sendRequest(fmt.Sprintf("/draft/%s/picks", draftID), &res)
+	return res, err
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	sleeper "github.com/craigatron/sleeper-go"
+)
+
+// playerCacheAge is how long the cached list of NFL players is used before it's refetched.
+const playerCacheAge = 24 * time.Hour
+
+// config is the contents of the config file.
+type config struct {
+	Token string `json:"token"`
+}
+
+// app holds what's shared between commands.
+type app struct {
+	out io.Writer
+
+	c      *sleeper.Client
+	format outputFormat
+}
+
+// client creates the Sleeper client the first time it's needed.
+func (a *app) client() (sleeper.Client, error) {
+	if a.c != nil {
+		return *a.c, nil
+	}
+	token, err := loadToken()
+	if err != nil {
+		return sleeper.Client{}, err
+	}
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return sleeper.Client{}, err
+	}
+	c, err := sleeper.NewClientWithPlayerCache(token, filepath.Join(cacheDir, "sleeper", "players.json"), playerCacheAge)
+	if err != nil {
+		return c, err
+	}
+	a.c = &c
+	return c, nil
+}
+
+// league loads the given league with the shared client.
+func (a *app) league(leagueID string) (sleeper.League, error) {
+	c, err := a.client()
+	if err != nil {
+		return sleeper.League{}, err
+	}
+	return sleeper.NewLeagueWithClient(c, leagueID)
+}
+
+// loadToken reads the GraphQL token from the environment, falling back to the config file. It's fine for there to
+// be no token at all, since only some commands need one.
+func loadToken() (string, error) {
+	if token := os.Getenv("SLEEPER_TOKEN"); token != "" {
+		return token, nil
+	}
+	path := os.Getenv("SLEEPER_CONFIG")
+	if path == "" {
+		configDir, err := os.UserConfigDir()
+		if err != nil {
+			return "", nil
+		}
+		path = filepath.Join(configDir, "sleeper", "config.json")
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	cfg := config{}
+	if err := json.NewDecoder(f).Decode(&cfg); err != nil {
+		return "", err
+	}
+	return cfg.Token, nil
+}
+
+// parse parses a command's flags, which may come before, after or between its positional arguments, and checks the
+// number of positional arguments.
+func (a *app) parse(fs *flag.FlagSet, args []string, wantArgs int) ([]string, error) {
+	var jsonOut, csvOut bool
+	fs.BoolVar(&jsonOut, "json", false, "print JSON")
+	fs.BoolVar(&csvOut, "csv", false, "print CSV")
+	fs.SetOutput(io.Discard)
+
+	positional := make([]string, 0)
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, usageError(err.Error())
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+	if len(positional) != wantArgs {
+		return nil, usageError(fmt.Sprintf("%s takes %d argument(s), got %d", fs.Name(), wantArgs, len(positional)))
+	}
+
+	switch {
+	case jsonOut && csvOut:
+		return nil, usageError("only one of -json and -csv can be used")
+	case jsonOut:
+		a.format = formatJSON
+	case csvOut:
+		a.format = formatCSV
+	default:
+		a.format = formatTable
+	}
+	return positional, nil
+}
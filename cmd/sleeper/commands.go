@@ -0,0 +1,330 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	sleeper "github.com/craigatron/sleeper-go"
+)
+
+func userCommand(a *app, args []string) error {
+	fs := flag.NewFlagSet("user", flag.ContinueOnError)
+	args, err := a.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	user, err := c.GetUser(args[0])
+	if err != nil {
+		return err
+	}
+	// unknown users come back as null
+	if user.UserID == "" {
+		return fmt.Errorf("no user %q", args[0])
+	}
+
+	t := table{header: []string{"User ID", "Username", "Display Name"}}
+	t.add(user.UserID, user.Username, user.DisplayName)
+	return a.print(user, t)
+}
+
+func leaguesCommand(a *app, args []string) error {
+	fs := flag.NewFlagSet("leagues", flag.ContinueOnError)
+	season := fs.String("season", "", "season to list leagues for, defaults to the current one")
+	args, err := a.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	user, err := c.GetUser(args[0])
+	if err != nil {
+		return err
+	}
+	if user.UserID == "" {
+		return fmt.Errorf("no user %q", args[0])
+	}
+	if *season == "" {
+		status, err := c.GetNflStatus()
+		if err != nil {
+			return err
+		}
+		*season = status.Season
+	}
+	leagues, err := c.GetUserLeagues(user.UserID, *season)
+	if err != nil {
+		return err
+	}
+
+	t := table{header: []string{"League ID", "Name", "Season", "Status", "Teams", "Draft ID"}}
+	for _, l := range leagues {
+		t.add(l.LeagueID, l.Name, l.Season, l.Status, l.TotalRosters, l.DraftID)
+	}
+	return a.print(leagues, t)
+}
+
+func leagueInfoCommand(a *app, args []string) error {
+	fs := flag.NewFlagSet("league info", flag.ContinueOnError)
+	args, err := a.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	info, err := c.GetLeagueInfo(args[0])
+	if err != nil {
+		return err
+	}
+	if info.LeagueID == "" {
+		return fmt.Errorf("no league %q", args[0])
+	}
+
+	t := table{header: []string{"Setting", "Value"}}
+	t.add("Name", info.Name)
+	t.add("League ID", info.LeagueID)
+	t.add("Season", info.Season)
+	t.add("Status", info.Status)
+	t.add("Teams", info.TotalRosters)
+	t.add("Roster positions", strings.Join(info.RosterPositions, " "))
+	t.add("Playoff teams", info.Settings.PlayoffTeams)
+	t.add("Playoff week start", info.Settings.PlayoffWeekStart)
+	t.add("Waiver budget", info.Settings.WaiverBudget)
+	t.add("Trade deadline", info.Settings.TradeDeadline)
+	t.add("Draft ID", info.DraftID)
+	t.add("Previous league ID", info.PreviousLeagueID)
+	return a.print(info, t)
+}
+
+func rostersCommand(a *app, args []string) error {
+	fs := flag.NewFlagSet("rosters", flag.ContinueOnError)
+	args, err := a.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	l, err := a.league(args[0])
+	if err != nil {
+		return err
+	}
+
+	rosters := make(sleeper.RostersJSON, 0, len(l.Rosters))
+	for _, r := range l.Rosters {
+		rosters = append(rosters, r)
+	}
+	sort.Slice(rosters, func(i, j int) bool { return rosters[i].RosterID < rosters[j].RosterID })
+
+	t := table{header: []string{"Roster", "Team", "Record", "PF", "Starters"}}
+	for _, r := range rosters {
+		starters := make([]string, 0, len(r.Starters))
+		for _, p := range r.Starters {
+			starters = append(starters, l.Client.PlayerName(p))
+		}
+		record := fmt.Sprintf("%d-%d-%d", r.Settings.Wins, r.Settings.Losses, r.Settings.Ties)
+		t.add(r.RosterID, l.TeamName(r.RosterID), record, r.Settings.Fpts, strings.Join(starters, ", "))
+	}
+	return a.print(rosters, t)
+}
+
+func matchupsCommand(a *app, args []string) error {
+	fs := flag.NewFlagSet("matchups", flag.ContinueOnError)
+	week := fs.Int("week", 0, "week to show, defaults to the current one")
+	args, err := a.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	l, err := a.league(args[0])
+	if err != nil {
+		return err
+	}
+	if *week == 0 {
+		status, err := l.Client.GetNflStatus()
+		if err != nil {
+			return err
+		}
+		*week = status.Week
+	}
+
+	matchups, err := l.Client.GetLeagueMatchups(l.ID, *week)
+	if err != nil {
+		return err
+	}
+	sort.Slice(matchups, func(i, j int) bool {
+		if matchups[i].MatchupID != matchups[j].MatchupID {
+			return matchups[i].MatchupID < matchups[j].MatchupID
+		}
+		return matchups[i].RosterID < matchups[j].RosterID
+	})
+
+	t := table{header: []string{"Matchup", "Roster", "Team", "Points"}}
+	for _, m := range matchups {
+		t.add(m.MatchupID, m.RosterID, l.TeamName(m.RosterID), m.Points)
+	}
+	return a.print(matchups, t)
+}
+
+func standingsCommand(a *app, args []string) error {
+	fs := flag.NewFlagSet("standings", flag.ContinueOnError)
+	args, err := a.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	l, err := a.league(args[0])
+	if err != nil {
+		return err
+	}
+
+	standings := l.Standings()
+	t := table{header: []string{"Rank", "Roster", "Team", "W", "L", "T", "PF", "PA"}}
+	for _, s := range standings {
+		t.add(s.Rank, s.RosterID, l.TeamName(s.RosterID), s.Wins, s.Losses, s.Ties, s.PointsFor, s.PointsAgainst)
+	}
+	return a.print(standings, t)
+}
+
+func projectionsCommand(a *app, args []string) error {
+	fs := flag.NewFlagSet("projections", flag.ContinueOnError)
+	args, err := a.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	l, err := a.league(args[0])
+	if err != nil {
+		return err
+	}
+
+	projections, err := l.GetProjections(nil)
+	if err != nil {
+		return err
+	}
+	sort.Slice(projections, func(i, j int) bool {
+		if projections[i].Matchup.MatchupID != projections[j].Matchup.MatchupID {
+			return projections[i].Matchup.MatchupID < projections[j].Matchup.MatchupID
+		}
+		return projections[i].Matchup.RosterID < projections[j].Matchup.RosterID
+	})
+
+	t := table{header: []string{"Matchup", "Roster", "Team", "Points", "Projection"}}
+	for _, p := range projections {
+		t.add(p.Matchup.MatchupID, p.Matchup.RosterID, l.TeamName(p.Matchup.RosterID), p.Matchup.Points, p.Projection)
+	}
+	return a.print(projections, t)
+}
+
+func trendingCommand(a *app, args []string) error {
+	fs := flag.NewFlagSet("trending", flag.ContinueOnError)
+	trendType := fs.String("type", string(sleeper.TrendingPlayerTypeAdd), "add or drop")
+	limit := fs.Int("limit", 25, "maximum number of players to show")
+	_, err := a.parse(fs, args, 0)
+	if err != nil {
+		return err
+	}
+	if *trendType != string(sleeper.TrendingPlayerTypeAdd) && *trendType != string(sleeper.TrendingPlayerTypeDrop) {
+		return usageError(fmt.Sprintf("unknown trending type %q", *trendType))
+	}
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	trending, err := c.GetTrendingPlayers(sleeper.TrendingPlayerType(*trendType))
+	if err != nil {
+		return err
+	}
+	if *limit > 0 && len(trending) > *limit {
+		trending = trending[:*limit]
+	}
+
+	t := table{header: []string{"Player ID", "Name", "Position", "Team", "Count"}}
+	for _, p := range trending {
+		info := c.NFLPlayers[p.PlayerID]
+		t.add(p.PlayerID, c.PlayerName(p.PlayerID), info.Position, info.Team, p.Count)
+	}
+	return a.print(trending, t)
+}
+
+func draftPicksCommand(a *app, args []string) error {
+	fs := flag.NewFlagSet("draft picks", flag.ContinueOnError)
+	args, err := a.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	picks, err := c.GetDraftPicks(args[0])
+	if err != nil {
+		return err
+	}
+
+	t := table{header: []string{"Pick", "Round", "Slot", "Roster", "Player ID", "Name", "Position", "Team"}}
+	for _, p := range picks {
+		name := strings.TrimSpace(p.Metadata.FirstName + " " + p.Metadata.LastName)
+		if name == "" {
+			name = c.PlayerName(p.PlayerID)
+		}
+		t.add(p.PickNo, p.Round, p.DraftSlot, p.RosterID, p.PlayerID, name, p.Metadata.Position, p.Metadata.Team)
+	}
+	return a.print(picks, t)
+}
+
+func playersSearchCommand(a *app, args []string) error {
+	fs := flag.NewFlagSet("players search", flag.ContinueOnError)
+	position := fs.String("position", "", "only show players at this position")
+	team := fs.String("team", "", "only show players on this team")
+	active := fs.Bool("active", false, "only show active players")
+	limit := fs.Int("limit", 25, "maximum number of players to show")
+	args, err := a.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	filter := sleeper.PlayerFilter{ActiveOnly: *active, Team: strings.ToUpper(*team)}
+	if *position != "" {
+		filter.Positions = []string{strings.ToUpper(*position)}
+	}
+	matches := sleeper.NewPlayerIndex(c.NFLPlayers).Search(args[0], filter, *limit)
+	if len(matches) == 0 {
+		return fmt.Errorf("no players matching %q", args[0])
+	}
+
+	players := make([]sleeper.PlayerInfoJSON, len(matches))
+	t := table{header: []string{"Player ID", "Name", "Position", "Team", "Status"}}
+	for i, m := range matches {
+		players[i] = m.Player
+		t.add(m.Player.PlayerID, c.PlayerName(m.Player.PlayerID), m.Player.Position, m.Player.Team, m.Player.Status)
+	}
+	return a.print(players, t)
+}
+
+func playersIDsCommand(a *app, args []string) error {
+	fs := flag.NewFlagSet("players ids", flag.ContinueOnError)
+	_, err := a.parse(fs, args, 0)
+	if err != nil {
+		return err
+	}
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	ids := sleeper.NewPlayerIndex(c.NFLPlayers).IDMap()
+	records := ids.Records()
+	return a.print(ids, table{header: records[0], rows: records[1:]})
+}
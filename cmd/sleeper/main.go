@@ -0,0 +1,91 @@
+// Command sleeper looks up users, leagues, players and drafts in the Sleeper API.
+//
+// Usage:
+//
+//	sleeper <command> [flags] [args]
+//
+// Every command prints a table by default, or CSV or JSON with -csv or -json. The GraphQL token needed for
+// projections is read from the SLEEPER_TOKEN environment variable, or the "token" key of a JSON config file at
+// $SLEEPER_CONFIG or sleeper/config.json in the user config directory. The list of NFL players is cached for a day
+// in the user cache directory.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+const usage = `usage: sleeper <command> [flags] [args]
+
+commands:
+  user <username>               look up a user
+  leagues <username>            list a user's leagues (-season)
+  league info <league id>       show a league's settings
+  rosters <league id>           list a league's rosters
+  matchups <league id>          show a week's matchups (-week)
+  standings <league id>         show the standings
+  projections <league id>       project this week's matchups
+  trending                      list trending players (-type add|drop, -limit)
+  draft picks <draft id>        list the picks in a draft
+  players search <name>         search for players (-position, -team, -active)
+  players ids                   map Sleeper player IDs to ESPN, Yahoo, GSIS and other IDs
+
+output flags, accepted by every command:
+  -json                         print JSON
+  -csv                          print CSV
+`
+
+// command runs a single subcommand with the arguments that follow its name.
+type command func(a *app, args []string) error
+
+var commands = map[string]command{
+	"user":        userCommand,
+	"leagues":     leaguesCommand,
+	"league":      subcommands(map[string]command{"info": leagueInfoCommand}),
+	"rosters":     rostersCommand,
+	"matchups":    matchupsCommand,
+	"standings":   standingsCommand,
+	"projections": projectionsCommand,
+	"trending":    trendingCommand,
+	"draft":       subcommands(map[string]command{"picks": draftPicksCommand}),
+	"players": subcommands(map[string]command{
+		"search": playersSearchCommand,
+		"ids":    playersIDsCommand,
+	}),
+}
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] == "-h" || os.Args[1] == "-help" || os.Args[1] == "help" {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+
+	a := &app{out: os.Stdout}
+	if err := cmd(a, os.Args[2:]); err != nil {
+		fmt.Fprintf(os.Stderr, "sleeper: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// subcommands dispatches on the first argument, for commands like "league info".
+func subcommands(cmds map[string]command) command {
+	return func(a *app, args []string) error {
+		if len(args) == 0 {
+			return usageError("missing subcommand")
+		}
+		cmd, ok := cmds[args[0]]
+		if !ok {
+			return usageError(fmt.Sprintf("unknown subcommand %q", args[0]))
+		}
+		return cmd(a, args[1:])
+	}
+}
+
+func usageError(msg string) error {
+	return fmt.Errorf("%s\n\n%s", msg, usage)
+}
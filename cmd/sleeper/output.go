@@ -0,0 +1,67 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+)
+
+type outputFormat int
+
+const (
+	formatTable outputFormat = iota
+	formatCSV
+	formatJSON
+)
+
+// table is the tabular form of a command's output.
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+func (t *table) add(cells ...interface{}) {
+	row := make([]string, len(cells))
+	for i, c := range cells {
+		switch c := c.(type) {
+		case float32:
+			row[i] = fmt.Sprintf("%.2f", c)
+		case float64:
+			row[i] = fmt.Sprintf("%.2f", c)
+		case nil:
+			row[i] = ""
+		default:
+			row[i] = fmt.Sprint(c)
+		}
+	}
+	t.rows = append(t.rows, row)
+}
+
+// print writes the command's output in the chosen format. JSON output is the raw value from the library rather
+// than the table, so nothing is lost.
+func (a *app) print(raw interface{}, t table) error {
+	switch a.format {
+	case formatJSON:
+		enc := json.NewEncoder(a.out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(raw)
+	case formatCSV:
+		w := csv.NewWriter(a.out)
+		if err := w.Write(t.header); err != nil {
+			return err
+		}
+		if err := w.WriteAll(t.rows); err != nil {
+			return err
+		}
+		return w.Error()
+	}
+
+	w := tabwriter.NewWriter(a.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(t.header, "\t"))
+	for _, row := range t.rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
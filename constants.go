@@ -12,3 +12,6 @@ const (
 	// TrendingPlayerTypeDrop is "drop"
 	TrendingPlayerTypeDrop = "drop"
 )
+
+// the last week of the NFL regular season, which is also the latest a Sleeper league can run
+const nflRegularSeasonWeeks = 18
@@ -0,0 +1,294 @@
+package sleeper
+
+import "context"
+
+// EventType identifies the kind of an Event.
+type EventType string
+
+const (
+	// EventTypeTradeCompleted is for TradeCompleted events.
+	EventTypeTradeCompleted EventType = "trade_completed"
+	// EventTypeWaiverProcessed is for WaiverProcessed events.
+	EventTypeWaiverProcessed EventType = "waiver_processed"
+	// EventTypeFreeAgentAdded is for FreeAgentAdded events.
+	EventTypeFreeAgentAdded EventType = "free_agent_added"
+	// EventTypeRosterChanged is for RosterChanged events.
+	EventTypeRosterChanged EventType = "roster_changed"
+	// EventTypeLineupChanged is for LineupChanged events.
+	EventTypeLineupChanged EventType = "lineup_changed"
+	// EventTypeScoreUpdated is for ScoreUpdated events.
+	EventTypeScoreUpdated EventType = "score_updated"
+	// EventTypeMatchupFinal is for MatchupFinal events.
+	EventTypeMatchupFinal EventType = "matchup_final"
+	// EventTypeGameKickoff is for GameKickoff events.
+	EventTypeGameKickoff EventType = "game_kickoff"
+	// EventTypeQuarterChanged is for QuarterChanged events.
+	EventTypeQuarterChanged EventType = "quarter_changed"
+	// EventTypeGameScoreChanged is for GameScoreChanged events.
+	EventTypeGameScoreChanged EventType = "game_score_changed"
+	// EventTypeRedZoneEntered is for RedZoneEntered events.
+	EventTypeRedZoneEntered EventType = "red_zone_entered"
+	// EventTypePossessionChanged is for PossessionChanged events.
+	EventTypePossessionChanged EventType = "possession_changed"
+	// EventTypeGameFinal is for GameFinal events.
+	EventTypeGameFinal EventType = "game_final"
+	// EventTypePlayerTeamChanged is for PlayerTeamChanged events.
+	EventTypePlayerTeamChanged EventType = "player_team_changed"
+	// EventTypePlayerStatusChanged is for PlayerStatusChanged events.
+	EventTypePlayerStatusChanged EventType = "player_status_changed"
+	// EventTypePlayerInjuryChanged is for PlayerInjuryChanged events.
+	EventTypePlayerInjuryChanged EventType = "player_injury_changed"
+	// EventTypePlayerDepthChartChanged is for PlayerDepthChartChanged events.
+	EventTypePlayerDepthChartChanged EventType = "player_depth_chart_changed"
+	// EventTypePlayerAdded is for PlayerAdded events.
+	EventTypePlayerAdded EventType = "player_added"
+)
+
+// Event is something that happened in a league, an NFL game or to an NFL player.
+type Event interface {
+	EventType() EventType
+}
+
+// emitter delivers events to a channel and any registered callbacks.
+type emitter struct {
+	events    chan Event
+	callbacks []func(Event)
+}
+
+// Events returns a channel that receives every event. It must be called before Run, and the channel must be
+// drained since the watcher blocks until each event is received. The channel is closed when Run returns.
+func (e *emitter) Events() <-chan Event {
+	if e.events == nil {
+		e.events = make(chan Event)
+	}
+	return e.events
+}
+
+// OnEvent registers a callback that's called with every event. It must be called before Run.
+func (e *emitter) OnEvent(f func(Event)) {
+	e.callbacks = append(e.callbacks, f)
+}
+
+// emit sends an event to every listener, giving up if the context is cancelled.
+func (e *emitter) emit(ctx context.Context, ev Event) {
+	for _, f := range e.callbacks {
+		f(ev)
+	}
+	if e.events != nil {
+		select {
+		case e.events <- ev:
+		case <-ctx.Done():
+		}
+	}
+}
+
+func (e *emitter) close() {
+	if e.events != nil {
+		close(e.events)
+	}
+}
+
+// TradeCompleted is emitted when a trade goes through.
+type TradeCompleted struct {
+	LeagueID    string
+	Transaction TransactionJSON
+}
+
+// EventType implements Event.
+func (TradeCompleted) EventType() EventType { return EventTypeTradeCompleted }
+
+// WaiverProcessed is emitted when a waiver claim is processed. Check the transaction's status to see whether the
+// claim was successful.
+type WaiverProcessed struct {
+	LeagueID    string
+	Transaction TransactionJSON
+}
+
+// EventType implements Event.
+func (WaiverProcessed) EventType() EventType { return EventTypeWaiverProcessed }
+
+// FreeAgentAdded is emitted when someone picks up a free agent.
+type FreeAgentAdded struct {
+	LeagueID    string
+	Transaction TransactionJSON
+}
+
+// EventType implements Event.
+func (FreeAgentAdded) EventType() EventType { return EventTypeFreeAgentAdded }
+
+// RosterChanged is emitted when players join or leave a roster. It's emitted alongside any transaction events, and
+// also catches moves that don't show up as transactions.
+type RosterChanged struct {
+	LeagueID string
+	RosterID int
+	Added    []string
+	Removed  []string
+}
+
+// EventType implements Event.
+func (RosterChanged) EventType() EventType { return EventTypeRosterChanged }
+
+// LineupChanged is emitted when a team changes its starters for the current week.
+type LineupChanged struct {
+	LeagueID string
+	Week     int
+	RosterID int
+	Before   []string
+	After    []string
+}
+
+// EventType implements Event.
+func (LineupChanged) EventType() EventType { return EventTypeLineupChanged }
+
+// ScoreUpdated is emitted when a team's score for the current week changes.
+type ScoreUpdated struct {
+	LeagueID       string
+	Week           int
+	MatchupID      int
+	RosterID       int
+	PreviousPoints float64
+	Points         float64
+}
+
+// EventType implements Event.
+func (ScoreUpdated) EventType() EventType { return EventTypeScoreUpdated }
+
+// MatchupFinal is emitted for every matchup once its week is over.
+type MatchupFinal struct {
+	LeagueID  string
+	Week      int
+	MatchupID int
+	Teams     [2]MatchupJSON
+}
+
+// EventType implements Event.
+func (MatchupFinal) EventType() EventType { return EventTypeMatchupFinal }
+
+// GameInfo identifies the NFL game a game event is about.
+type GameInfo struct {
+	GameID    string
+	Week      int
+	HomeTeam  string
+	AwayTeam  string
+	HomeScore int
+	AwayScore int
+}
+
+// GameKickoff is emitted when a game starts.
+type GameKickoff struct {
+	Game GameInfo
+}
+
+// EventType implements Event.
+func (GameKickoff) EventType() EventType { return EventTypeGameKickoff }
+
+// QuarterChanged is emitted when a game moves to a new quarter. Overtime is quarter 5.
+type QuarterChanged struct {
+	Game            GameInfo
+	PreviousQuarter int
+	Quarter         int
+}
+
+// EventType implements Event.
+func (QuarterChanged) EventType() EventType { return EventTypeQuarterChanged }
+
+// GameScoreChanged is emitted when either team scores.
+type GameScoreChanged struct {
+	Game              GameInfo
+	PreviousHomeScore int
+	PreviousAwayScore int
+}
+
+// EventType implements Event.
+func (GameScoreChanged) EventType() EventType { return EventTypeGameScoreChanged }
+
+// RedZoneEntered is emitted when a team drives into the red zone.
+type RedZoneEntered struct {
+	Game GameInfo
+	// Team is the team with the ball.
+	Team string
+}
+
+// EventType implements Event.
+func (RedZoneEntered) EventType() EventType { return EventTypeRedZoneEntered }
+
+// PossessionChanged is emitted when the ball changes hands.
+type PossessionChanged struct {
+	Game               GameInfo
+	PreviousPossession string
+	Possession         string
+}
+
+// EventType implements Event.
+func (PossessionChanged) EventType() EventType { return EventTypePossessionChanged }
+
+// GameFinal is emitted when a game ends.
+type GameFinal struct {
+	Game GameInfo
+}
+
+// EventType implements Event.
+func (GameFinal) EventType() EventType { return EventTypeGameFinal }
+
+// PlayerInfo identifies the player a player event is about.
+type PlayerInfo struct {
+	PlayerID string
+	Name     string
+	Position string
+	Team     string
+}
+
+// PlayerTeamChanged is emitted when a player is traded, signed or released. Team is blank for free agents.
+type PlayerTeamChanged struct {
+	Player       PlayerInfo
+	PreviousTeam string
+}
+
+// EventType implements Event.
+func (PlayerTeamChanged) EventType() EventType { return EventTypePlayerTeamChanged }
+
+// PlayerStatusChanged is emitted when a player's roster status (e.g. "Active" or "Injured Reserve") or whether
+// Sleeper considers them active changes.
+type PlayerStatusChanged struct {
+	Player         PlayerInfo
+	PreviousStatus string
+	Status         string
+	PreviousActive bool
+	Active         bool
+}
+
+// EventType implements Event.
+func (PlayerStatusChanged) EventType() EventType { return EventTypePlayerStatusChanged }
+
+// PlayerInjuryChanged is emitted when a player's injury status changes. InjuryStatus is blank once they're healthy.
+type PlayerInjuryChanged struct {
+	Player               PlayerInfo
+	PreviousInjuryStatus string
+	InjuryStatus         string
+	BodyPart             string
+}
+
+// EventType implements Event.
+func (PlayerInjuryChanged) EventType() EventType { return EventTypePlayerInjuryChanged }
+
+// PlayerDepthChartChanged is emitted when a player moves on their team's depth chart.
+type PlayerDepthChartChanged struct {
+	Player PlayerInfo
+	// positions are depth chart spots like "LWR", and orders start at 1 for the starter
+	PreviousPosition string
+	PreviousOrder    int
+	DepthPosition    string
+	Order            int
+}
+
+// EventType implements Event.
+func (PlayerDepthChartChanged) EventType() EventType { return EventTypePlayerDepthChartChanged }
+
+// PlayerAdded is emitted for a player who's new to Sleeper's player list, usually an undrafted or newly drafted
+// rookie.
+type PlayerAdded struct {
+	Player PlayerInfo
+}
+
+// EventType implements Event.
+func (PlayerAdded) EventType() EventType { return EventTypePlayerAdded }
@@ -0,0 +1,90 @@
+package sleeper
+
+import "sort"
+
+// FAABBid is a single waiver claim with a FAAB bid.
+type FAABBid struct {
+	TransactionID string
+	Week          int
+	RosterID      int
+	PlayerID      string
+	Position      string
+	Bid           int
+	// Won is false for claims that failed, usually because someone else bid more.
+	Won bool
+}
+
+// FAABHistory is every FAAB bid in the league this season, along with some aggregate stats.
+type FAABHistory struct {
+	Bids                        []FAABBid
+	AverageWinningBidByPosition map[string]float64
+	AverageWinningBidByWeek     map[int]float64
+	// budgets keyed by roster ID
+	BudgetSpent     map[int]int
+	BudgetRemaining map[int]int
+}
+
+// FAABHistory collects every waiver claim made this season. Sleeper includes failed claims in the transactions
+// API, so losing bids are listed alongside the winning ones.
+func (l League) FAABHistory() (FAABHistory, error) {
+	history := FAABHistory{
+		AverageWinningBidByPosition: make(map[string]float64),
+		AverageWinningBidByWeek:     make(map[int]float64),
+		BudgetSpent:                 make(map[int]int),
+		BudgetRemaining:             make(map[int]int),
+	}
+
+	for week := 1; week <= nflRegularSeasonWeeks; week++ {
+		transactions, err := l.Client.GetLeagueTransactions(l.ID, week)
+		if err != nil {
+			return history, err
+		}
+		for _, tx := range transactions {
+			if tx.Type != "waiver" {
+				continue
+			}
+			for playerID, rosterID := range tx.Adds {
+				history.Bids = append(history.Bids, FAABBid{
+					TransactionID: tx.TransactionID,
+					Week:          week,
+					RosterID:      rosterID,
+					PlayerID:      playerID,
+					Position:      l.Client.NFLPlayers[playerID].Position,
+					Bid:           tx.Settings.WaiverBid,
+					Won:           tx.Status == "complete",
+				})
+			}
+		}
+	}
+	sort.SliceStable(history.Bids, func(i, j int) bool {
+		if history.Bids[i].Week != history.Bids[j].Week {
+			return history.Bids[i].Week < history.Bids[j].Week
+		}
+		return history.Bids[i].Bid > history.Bids[j].Bid
+	})
+
+	positionTotals, positionCounts := make(map[string]int), make(map[string]int)
+	weekTotals, weekCounts := make(map[int]int), make(map[int]int)
+	for _, b := range history.Bids {
+		if !b.Won {
+			continue
+		}
+		positionTotals[b.Position] += b.Bid
+		positionCounts[b.Position]++
+		weekTotals[b.Week] += b.Bid
+		weekCounts[b.Week]++
+	}
+	for pos, total := range positionTotals {
+		history.AverageWinningBidByPosition[pos] = float64(total) / float64(positionCounts[pos])
+	}
+	for week, total := range weekTotals {
+		history.AverageWinningBidByWeek[week] = float64(total) / float64(weekCounts[week])
+	}
+
+	for rosterID, r := range l.Rosters {
+		history.BudgetSpent[rosterID] = r.Settings.WaiverBudgetUsed
+		history.BudgetRemaining[rosterID] = l.LeagueInfo.Settings.WaiverBudget - r.Settings.WaiverBudgetUsed
+	}
+
+	return history, nil
+}
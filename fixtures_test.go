@@ -0,0 +1,21 @@
+package sleeper
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// loadFixture decodes a JSON file from testdata into v.
+func loadFixture(t *testing.T, name string, v interface{}) {
+	t.Helper()
+	f, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := json.NewDecoder(f).Decode(v); err != nil {
+		t.Fatalf("decoding %s: %v", name, err)
+	}
+}
@@ -0,0 +1,189 @@
+package sleeper
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	defaultGamePollInterval = 30 * time.Second
+	defaultMaxIdle          = 2 * time.Hour
+)
+
+// gameState is the part of a game's batch scores that the GameWatcher tracks between polls.
+type gameState struct {
+	status     string
+	quarter    int
+	homeScore  int
+	awayScore  int
+	redZone    bool
+	possession string
+}
+
+// GameWatcher polls the live scoreboard for a week of NFL games and emits events as games progress. It needs a
+// client with a GraphQL token.
+type GameWatcher struct {
+	Client Client
+	Season string
+	Week   int
+	// PollInterval is how often to poll while games are in progress. Defaults to 30 seconds.
+	PollInterval time.Duration
+	// MaxIdle is the longest the watcher will wait for the next kickoff when no games are in progress. If the next
+	// game is further away than this, Run returns. Defaults to 2 hours.
+	MaxIdle time.Duration
+	// ErrorHandler is called with any error hit while polling. Polling carries on at the next interval either way.
+	ErrorHandler func(error)
+
+	emitter
+
+	games map[string]gameState
+}
+
+// NewGameWatcher creates a watcher for the given week's games.
+func NewGameWatcher(c Client, season string, week int) *GameWatcher {
+	return &GameWatcher{
+		Client:       c,
+		Season:       season,
+		Week:         week,
+		PollInterval: defaultGamePollInterval,
+		MaxIdle:      defaultMaxIdle,
+	}
+}
+
+// Run polls the scoreboard until every game is final, the next kickoff is more than MaxIdle away, or the context is
+// cancelled. Between game windows it sleeps until the next kickoff rather than polling. The first poll only records
+// the current state of each game.
+func (w *GameWatcher) Run(ctx context.Context) error {
+	defer w.close()
+
+	notify := false
+	for {
+		wait, done, err := w.poll(ctx, notify)
+		if err != nil {
+			if w.ErrorHandler != nil {
+				w.ErrorHandler(err)
+			}
+			wait, done = w.pollInterval(), false
+		} else {
+			notify = true
+		}
+		if done {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+	}
+}
+
+func (w *GameWatcher) pollInterval() time.Duration {
+	if w.PollInterval <= 0 {
+		return defaultGamePollInterval
+	}
+	return w.PollInterval
+}
+
+// poll fetches the scoreboard, emits events for anything that changed, and works out how long to wait before the
+// next poll, or whether to stop altogether.
+func (w *GameWatcher) poll(ctx context.Context, notify bool) (time.Duration, bool, error) {
+	scores, err := w.Client.GetBatchScores(w.Week, w.Season)
+	if err != nil {
+		return 0, false, err
+	}
+
+	current := make(map[string]gameState)
+	inProgress := false
+	var nextKickoff time.Time
+	for _, game := range scores.Data.Scores {
+		state := gameState{
+			status:     game.Status,
+			homeScore:  game.Metadata.HomeScore,
+			awayScore:  game.Metadata.AwayScore,
+			redZone:    inRedZone(game.Metadata.RedZone),
+			possession: game.Metadata.Possession,
+		}
+		// quarter_num is blank before kickoff, at halftime and so on
+		if quarter, err := quarterNumber(game.Metadata.QuarterNum); err == nil {
+			state.quarter = quarter
+		} else if previous, ok := w.games[game.GameID]; ok {
+			state.quarter = previous.quarter
+		}
+		current[game.GameID] = state
+
+		switch game.Status {
+		case "pre_game":
+			// start_time is in milliseconds
+			kickoff := time.UnixMilli(game.StartTime)
+			if nextKickoff.IsZero() || kickoff.Before(nextKickoff) {
+				nextKickoff = kickoff
+			}
+		case "complete":
+		default:
+			inProgress = true
+		}
+
+		if previous, ok := w.games[game.GameID]; ok && notify {
+			info := GameInfo{
+				GameID:    game.GameID,
+				Week:      w.Week,
+				HomeTeam:  game.Metadata.HomeTeam,
+				AwayTeam:  game.Metadata.AwayTeam,
+				HomeScore: state.homeScore,
+				AwayScore: state.awayScore,
+			}
+			w.diff(ctx, info, previous, state)
+		}
+	}
+	w.games = current
+
+	switch {
+	case inProgress:
+		return w.pollInterval(), false, nil
+	case nextKickoff.IsZero():
+		// everything's final
+		return 0, true, nil
+	}
+	untilKickoff := time.Until(nextKickoff)
+	maxIdle := w.MaxIdle
+	if maxIdle <= 0 {
+		maxIdle = defaultMaxIdle
+	}
+	if untilKickoff > maxIdle {
+		return 0, true, nil
+	}
+	if untilKickoff < w.pollInterval() {
+		return w.pollInterval(), false, nil
+	}
+	return untilKickoff, false, nil
+}
+
+func (w *GameWatcher) diff(ctx context.Context, info GameInfo, previous gameState, current gameState) {
+	if previous.status == "pre_game" && current.status != "pre_game" {
+		w.emit(ctx, GameKickoff{Game: info})
+	}
+	if current.quarter != previous.quarter && previous.quarter != 0 {
+		w.emit(ctx, QuarterChanged{Game: info, PreviousQuarter: previous.quarter, Quarter: current.quarter})
+	}
+	if current.homeScore != previous.homeScore || current.awayScore != previous.awayScore {
+		w.emit(ctx, GameScoreChanged{Game: info, PreviousHomeScore: previous.homeScore, PreviousAwayScore: previous.awayScore})
+	}
+	if current.possession != previous.possession && current.possession != "" {
+		w.emit(ctx, PossessionChanged{Game: info, PreviousPossession: previous.possession, Possession: current.possession})
+	}
+	if current.redZone && !previous.redZone {
+		w.emit(ctx, RedZoneEntered{Game: info, Team: current.possession})
+	}
+	if current.status == "complete" && previous.status != "complete" {
+		w.emit(ctx, GameFinal{Game: info})
+	}
+}
+
+// inRedZone interprets the red_zone metadata, which is blank or "false" when nobody is in the red zone.
+func inRedZone(redZone string) bool {
+	return redZone != "" && redZone != "false" && redZone != "0"
+}
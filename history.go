@@ -0,0 +1,102 @@
+package sleeper
+
+import "fmt"
+
+// LeagueHistory is every season of a league, oldest first.
+type LeagueHistory []League
+
+// OwnerSeason is one owner's team in a single season.
+type OwnerSeason struct {
+	Season      string
+	LeagueID    string
+	RosterID    int
+	DisplayName string
+	TeamName    string
+}
+
+// LoadLeagueHistory loads the given league and then follows PreviousLeagueID back to the league's first season.
+// Every season shares a single client, so the NFL player list is only fetched once.
+func LoadLeagueHistory(leagueID string, token string) (LeagueHistory, error) {
+	c, err := NewClientWithToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make(LeagueHistory, 0)
+	seen := make(map[string]bool)
+	for id := leagueID; id != "" && id != "0"; {
+		if seen[id] {
+			return nil, fmt.Errorf("league %s appears twice in the league history", id)
+		}
+		seen[id] = true
+
+		l, err := newLeagueWithClient(c, id, token)
+		if err != nil {
+			return nil, err
+		}
+		// older seasons need to be looked up with their own season rather than the current one
+		l.Season = l.LeagueInfo.Season
+		history = append(history, l)
+		id = l.LeagueInfo.PreviousLeagueID
+	}
+
+	// flip to oldest first
+	for i, j := 0, len(history)-1; i < j; i, j = i+1, j-1 {
+		history[i], history[j] = history[j], history[i]
+	}
+	return history, nil
+}
+
+// Season returns the league for the given season, if it's part of the history.
+func (h LeagueHistory) Season(season string) (League, bool) {
+	for _, l := range h {
+		if l.Season == season {
+			return l, true
+		}
+	}
+	return League{}, false
+}
+
+// Owners maps every owner ID in the history to the teams they've had, oldest first. Team names can change from
+// season to season but the owner ID stays the same.
+func (h LeagueHistory) Owners() map[string][]OwnerSeason {
+	owners := make(map[string][]OwnerSeason)
+	for _, l := range h {
+		for _, r := range l.Rosters {
+			if r.OwnerID == "" {
+				continue
+			}
+			owners[r.OwnerID] = append(owners[r.OwnerID], OwnerSeason{
+				Season:      l.Season,
+				LeagueID:    l.ID,
+				RosterID:    r.RosterID,
+				DisplayName: l.Users[r.OwnerID].DisplayName,
+				TeamName:    l.TeamName(r.RosterID),
+			})
+		}
+	}
+	return owners
+}
+
+// OwnerID returns the owner of the given roster in the given season, or "" if it isn't known.
+func (h LeagueHistory) OwnerID(season string, rosterID int) string {
+	l, ok := h.Season(season)
+	if !ok {
+		return ""
+	}
+	return l.Rosters[rosterID].OwnerID
+}
+
+// RosterID returns the roster the given owner had in the given season.
+func (h LeagueHistory) RosterID(season string, ownerID string) (int, bool) {
+	l, ok := h.Season(season)
+	if !ok {
+		return 0, false
+	}
+	for _, r := range l.Rosters {
+		if r.OwnerID == ownerID {
+			return r.RosterID, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,115 @@
+package sleeper
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+)
+
+// PlayerIDs is a player's ID on each site Sleeper knows about. Zero or blank IDs mean the player isn't on that site.
+type PlayerIDs struct {
+	PlayerID      string `json:"player_id"`
+	Name          string `json:"name"`
+	Position      string `json:"position"`
+	Team          string `json:"team"`
+	ESPNID        int    `json:"espn_id,omitempty"`
+	YahooID       int    `json:"yahoo_id,omitempty"`
+	SportradarID  string `json:"sportradar_id,omitempty"`
+	RotowireID    int    `json:"rotowire_id,omitempty"`
+	FantasyDataID int    `json:"fantasy_data_id,omitempty"`
+	GSISID        string `json:"gsis_id,omitempty"`
+	StatsID       string `json:"stats_id,omitempty"`
+}
+
+// IDMap is a crosswalk from Sleeper player IDs to IDs on other sites. GSIS IDs are what nflverse uses.
+type IDMap map[string]PlayerIDs
+
+// idMapHeader is the header row of IDMap.WriteCSV.
+var idMapHeader = []string{
+	"player_id", "name", "position", "team", "espn_id", "yahoo_id", "sportradar_id", "rotowire_id", "fantasy_data_id",
+	"gsis_id", "stats_id",
+}
+
+// IDMap builds the ID crosswalk for every player in the index.
+func (idx *PlayerIndex) IDMap() IDMap {
+	m := make(IDMap)
+	for _, p := range idx.players {
+		m[p.PlayerID] = PlayerIDs{
+			PlayerID:      p.PlayerID,
+			Name:          fullName(p),
+			Position:      p.Position,
+			Team:          stringField(p.Team),
+			ESPNID:        p.EspnID,
+			YahooID:       p.YahooID,
+			SportradarID:  p.SportradarID,
+			RotowireID:    p.RotowireID,
+			FantasyDataID: p.FantasyDataID,
+			GSISID:        idField(p.GsisID),
+			StatsID:       idField(p.StatsID),
+		}
+	}
+	return m
+}
+
+// Records returns the crosswalk's rows ordered by Sleeper player ID, with a header row first.
+func (m IDMap) Records() [][]string {
+	ids := make([]string, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	records := [][]string{idMapHeader}
+	for _, id := range ids {
+		p := m[id]
+		records = append(records, []string{
+			p.PlayerID, p.Name, p.Position, p.Team, intID(p.ESPNID), intID(p.YahooID), p.SportradarID,
+			intID(p.RotowireID), intID(p.FantasyDataID), p.GSISID, p.StatsID,
+		})
+	}
+	return records
+}
+
+// WriteCSV writes the crosswalk as CSV, ordered by Sleeper player ID.
+func (m IDMap) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.WriteAll(m.Records()); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+// WriteJSON writes the crosswalk as a JSON object keyed by Sleeper player ID.
+func (m IDMap) WriteJSON(w io.Writer) error {
+	return json.NewEncoder(w).Encode(m)
+}
+
+// BySite returns a map from one site's IDs to Sleeper player IDs, for joining another site's data with Sleeper's.
+// Sites are named the same as the CSV columns, e.g. "espn_id" or "gsis_id".
+func (m IDMap) BySite(site string) map[string]string {
+	column := -1
+	for i, h := range idMapHeader {
+		if h == site {
+			column = i
+		}
+	}
+	reverse := make(map[string]string)
+	if column < 0 {
+		return reverse
+	}
+	for _, record := range m.Records()[1:] {
+		if record[column] != "" {
+			reverse[record[column]] = record[0]
+		}
+	}
+	return reverse
+}
+
+func intID(id int) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.Itoa(id)
+}
@@ -0,0 +1,130 @@
+package sleeper
+
+import "sort"
+
+// injury statuses from most to least serious. Anything else Sleeper sends, like "NA", goes after these.
+var injuryStatusOrder = []string{"IR", "PUP", "Out", "Sus", "COV", "Doubtful", "Questionable"}
+
+// InjuredPlayer is a rostered player with an injury designation.
+type InjuredPlayer struct {
+	PlayerID string
+	Name     string
+	Position string
+	Team     string
+	RosterID int
+	// Status is the player's injury status, e.g. "Questionable".
+	Status                string
+	BodyPart              string
+	Notes                 string
+	StartDate             string
+	PracticeParticipation string
+	PracticeDescription   string
+	// Starter is true if the player is in their roster's starting lineup this week.
+	Starter bool
+	// Reserve is true if the player is in one of their roster's IR slots.
+	Reserve bool
+	// Severity ranks the injury status, higher being more serious.
+	Severity int
+}
+
+// InjuryReport lists every injured player on a roster in the league for the current week.
+type InjuryReport struct {
+	Week    int
+	Players []InjuredPlayer
+}
+
+// Starters returns the injured players who are in a starting lineup, i.e. the lineups that need fixing before
+// kickoff.
+func (r InjuryReport) Starters() []InjuredPlayer {
+	starters := make([]InjuredPlayer, 0)
+	for _, p := range r.Players {
+		if p.Starter {
+			starters = append(starters, p)
+		}
+	}
+	return starters
+}
+
+// InjuryReport returns every rostered player with an injury status, flagging those starting in the current week.
+// Players are ordered from most to least serious injury, with starters first within each status.
+func (l League) InjuryReport() (InjuryReport, error) {
+	state, err := l.Client.GetNflStatus()
+	if err != nil {
+		return InjuryReport{}, err
+	}
+	report := InjuryReport{Week: state.Week}
+
+	matchups, err := l.Client.GetLeagueMatchups(l.ID, state.Week)
+	if err != nil {
+		return report, err
+	}
+	starting := make(map[string]bool)
+	for _, m := range matchups {
+		for _, p := range m.Starters {
+			starting[p] = true
+		}
+	}
+
+	for _, r := range l.Rosters {
+		reserve := make(map[string]bool)
+		for _, p := range r.Reserve {
+			reserve[p] = true
+		}
+		seen := make(map[string]bool)
+		for _, group := range [][]string{r.Players, r.Starters, r.Taxi, r.Reserve} {
+			for _, id := range group {
+				if id == emptySlot || seen[id] {
+					continue
+				}
+				seen[id] = true
+
+				info := l.Client.NFLPlayers[id]
+				status := stringField(info.InjuryStatus)
+				if status == "" {
+					continue
+				}
+				report.Players = append(report.Players, InjuredPlayer{
+					PlayerID:              id,
+					Name:                  l.playerName(id),
+					Position:              info.Position,
+					Team:                  stringField(info.Team),
+					RosterID:              r.RosterID,
+					Status:                status,
+					BodyPart:              stringField(info.InjuryBodyPart),
+					Notes:                 stringField(info.InjuryNotes),
+					StartDate:             stringField(info.InjuryStartDate),
+					PracticeParticipation: stringField(info.PracticeParticipation),
+					PracticeDescription:   stringField(info.PracticeDescription),
+					Starter:               starting[id],
+					Reserve:               reserve[id],
+					Severity:              injurySeverity(status),
+				})
+			}
+		}
+	}
+
+	sort.Slice(report.Players, func(i, j int) bool {
+		a, b := report.Players[i], report.Players[j]
+		if a.Severity != b.Severity {
+			return a.Severity > b.Severity
+		}
+		if a.Starter != b.Starter {
+			return a.Starter
+		}
+		if a.RosterID != b.RosterID {
+			return a.RosterID < b.RosterID
+		}
+		return a.PlayerID < b.PlayerID
+	})
+	return report, nil
+}
+
+// injurySeverity ranks an injury status, higher being more serious. Unknown statuses are 0.
+func injurySeverity(status string) int {
+	for i, s := range injuryStatusOrder {
+		if s == status {
+			return len(injuryStatusOrder) - i
+		}
+	}
+	return 0
+}
@@ -1,6 +1,10 @@
 package sleeper
 
-import "time"
+import (
+	"strconv"
+	"strings"
+	"time"
+)
 
 // AllPlayersJSON is the return type for the all players endpoint.
 type AllPlayersJSON map[string]PlayerInfoJSON
@@ -74,6 +78,17 @@ type SportStatusJSON struct {
 	DisplayWeek        int    `json:"display_week"`
 }
 
+// UserInfoJSON is the return type of the user API.
+type UserInfoJSON struct {
+	Username    string `json:"username"`
+	UserID      string `json:"user_id"`
+	DisplayName string `json:"display_name"`
+	Avatar      string `json:"avatar"`
+}
+
+// LeaguesJSON is the return type of the user leagues API.
+type LeaguesJSON []LeagueInfoJSON
+
 // LeagueInfoJSON is the return type of the league info API.
 type LeagueInfoJSON struct {
 	TotalRosters int    `json:"total_rosters"`
@@ -254,6 +269,104 @@ type MatchupJSON struct {
 	MatchupID    int      `json:"matchup_id"`
 	Points       float32  `json:"points"`
 	CustomPoints float32  `json:"custom_points"`
+
+	StartersPoints []float64          `json:"starters_points"`
+	PlayersPoints  map[string]float64 `json:"players_points"`
+}
+
+// TransactionsJSON is the return type of the league transactions API.
+type TransactionsJSON []TransactionJSON
+
+// TransactionJSON is a single trade, waiver claim, free agent move or commissioner action.
+type TransactionJSON struct {
+	TransactionID string `json:"transaction_id"`
+	// trade, waiver, free_agent or commissioner
+	Type string `json:"type"`
+	// complete, failed or pending
+	Status        string `json:"status"`
+	StatusUpdated int64  `json:"status_updated"`
+	Created       int64  `json:"created"`
+	Creator       string `json:"creator"`
+	// the week the transaction happened in
+	Leg          int   `json:"leg"`
+	RosterIDs    []int `json:"roster_ids"`
+	ConsenterIDs []int `json:"consenter_ids"`
+	// player ID to the roster ID that added or dropped them
+	Adds     map[string]int `json:"adds"`
+	Drops    map[string]int `json:"drops"`
+	Settings struct {
+		WaiverBid int `json:"waiver_bid"`
+		Seq       int `json:"seq"`
+	} `json:"settings"`
+	DraftPicks []struct {
+		Season          string `json:"season"`
+		Round           int    `json:"round"`
+		RosterID        int    `json:"roster_id"`
+		PreviousOwnerID int    `json:"previous_owner_id"`
+		OwnerID         int    `json:"owner_id"`
+	} `json:"draft_picks"`
+	WaiverBudget []struct {
+		Sender   int `json:"sender"`
+		Receiver int `json:"receiver"`
+		Amount   int `json:"amount"`
+	} `json:"waiver_budget"`
+	// known keys:
+	// notes
+	Metadata interface{} `json:"metadata"`
+}
+
+// BracketJSON is the return type of the league winners and losers bracket APIs.
+type BracketJSON []BracketMatchupJSON
+
+// BracketMatchupJSON is a single matchup in a playoff bracket.
+type BracketMatchupJSON struct {
+	Round   int `json:"r"`
+	Matchup int `json:"m"`
+	// roster IDs, which are null until the teams are decided
+	Team1  int `json:"t1"`
+	Team2  int `json:"t2"`
+	Winner int `json:"w"`
+	Loser  int `json:"l"`
+	// where each team came from, i.e. the winner or loser of an earlier matchup
+	Team1From struct {
+		W int `json:"w"`
+		L int `json:"l"`
+	} `json:"t1_from"`
+	Team2From struct {
+		W int `json:"w"`
+		L int `json:"l"`
+	} `json:"t2_from"`
+	// Place is the final placing decided by this matchup (e.g. 1 for the championship), if any
+	Place int `json:"p"`
+}
+
+// DraftPicksJSON is the return type of the draft picks API.
+type DraftPicksJSON []DraftPickJSON
+
+// DraftPickJSON is a single pick from the draft picks API.
+type DraftPickJSON struct {
+	PlayerID string `json:"player_id"`
+	// user ID of whoever made the pick
+	PickedBy string `json:"picked_by"`
+	// documented as a string but usually a number
+	RosterID  interface{} `json:"roster_id"`
+	Round     int         `json:"round"`
+	DraftSlot int         `json:"draft_slot"`
+	PickNo    int         `json:"pick_no"`
+	IsKeeper  interface{} `json:"is_keeper"`
+	DraftID   string      `json:"draft_id"`
+	Metadata  struct {
+		Team         string `json:"team"`
+		Status       string `json:"status"`
+		Sport        string `json:"sport"`
+		Position     string `json:"position"`
+		PlayerID     string `json:"player_id"`
+		Number       string `json:"number"`
+		NewsUpdated  string `json:"news_updated"`
+		LastName     string `json:"last_name"`
+		InjuryStatus string `json:"injury_status"`
+		FirstName    string `json:"first_name"`
+	} `json:"metadata"`
 }
 
 // BatchScoresJSON is the response from the batch_scores GraphQL request.
@@ -353,3 +466,32 @@ type PlayerStatsJSON struct {
 		Actual    []StatsJSON `json:"actual"`
 	} `json:"data"`
 }
+
+// stringField returns a loosely typed JSON field as a string, or "" if it's null or not a string.
+func stringField(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+// intField reads a number that Sleeper sends as either a number or a string, like depth_chart_order.
+func intField(v interface{}) int {
+	switch v := v.(type) {
+	case float64:
+		return int(v)
+	case string:
+		i, _ := strconv.Atoi(strings.TrimSpace(v))
+		return i
+	}
+	return 0
+}
+
+// idField reads an ID that Sleeper sends as either a string or a number, like stats_id.
+func idField(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return ""
+}
@@ -2,7 +2,6 @@ package sleeper
 
 import (
 	"fmt"
-	"math"
 	"strconv"
 	"strings"
 )
@@ -24,16 +23,25 @@ type League struct {
 
 // NewLeague creates a new Sleeper league with the given ID and token (for graphQL functionality).
 func NewLeague(leagueID string, token string) (League, error) {
-	l := League{
-		ID:    leagueID,
-		Token: token,
-	}
 	c, err := NewClientWithToken(token)
 	if err != nil {
-		return l, err
+		return League{ID: leagueID, Token: token}, err
 	}
+	return newLeagueWithClient(c, leagueID, token)
+}
+
+// NewLeagueWithClient loads a league using an existing client, which saves refetching every NFL player.
+func NewLeagueWithClient(c Client, leagueID string) (League, error) {
+	return newLeagueWithClient(c, leagueID, c.graphqlToken)
+}
 
-	l.Client = c
+// newLeagueWithClient loads a league using an existing client and the given GraphQL token.
+func newLeagueWithClient(c Client, leagueID string, token string) (League, error) {
+	l := League{
+		Client: c,
+		ID:     leagueID,
+		Token:  token,
+	}
 
 	status, err := c.GetNflStatus()
 	if err != nil {
@@ -81,72 +89,106 @@ func NewLeague(leagueID string, token string) (League, error) {
 	return l, nil
 }
 
+// TeamName returns the name of the given roster's team, falling back to the owner's display name if they haven't
+// named it.
+func (l League) TeamName(rosterID int) string {
+	roster, ok := l.Rosters[rosterID]
+	if !ok {
+		return fmt.Sprintf("Roster %d", rosterID)
+	}
+	user, ok := l.Users[roster.OwnerID]
+	if !ok {
+		return fmt.Sprintf("Roster %d", rosterID)
+	}
+	if user.Metadata.TeamName != "" {
+		return user.Metadata.TeamName
+	}
+	return user.DisplayName
+}
+
 // MatchupProjection is the projected score for a particular matchup.
 type MatchupProjection struct {
 	Matchup    MatchupJSON
 	Projection float64
 }
 
-// GetProjections returns the matchup projections for the current week.
-func (l League) GetProjections() ([]MatchupProjection, error) {
+// GetProjections returns the matchup projections for the current week, using the given model to project players
+// whose games are underway. A nil model uses SleeperProjectionModel.
+func (l League) GetProjections(model ProjectionModel) ([]MatchupProjection, error) {
+	if model == nil {
+		model = SleeperProjectionModel{}
+	}
+
 	state, err := l.Client.GetNflStatus()
 	if err != nil {
 		return nil, err
 	}
 
-	currentWeek := state.Week
-
-	matchups, err := l.Client.GetLeagueMatchups(l.ID, currentWeek)
+	matchups, inputs, err := l.projectionInputs(state.Week)
 	if err != nil {
 		return nil, err
 	}
 
+	projections := make([]MatchupProjection, 0)
+	for _, matchup := range matchups {
+		projection := 0.0
+		for _, startingPlayer := range matchup.Starters {
+			if in, ok := inputs[startingPlayer]; ok {
+				projection += model.ProjectPlayer(in)
+			}
+		}
+		projections = append(projections, MatchupProjection{
+			Matchup:    matchup,
+			Projection: projection,
+		})
+	}
+
+	return projections, nil
+}
+
+// projectionInputs fetches the given week's matchups along with the projection inputs for every starter who has a
+// projection.
+func (l League) projectionInputs(week int) (MatchupsJSON, map[string]ProjectionInput, error) {
+	matchups, err := l.Client.GetLeagueMatchups(l.ID, week)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// build the list of every active player this week
 	allActivePlayers := make([]string, 0)
 	for _, m := range matchups {
 		allActivePlayers = append(allActivePlayers, m.Starters...)
 	}
 
-	playerStats, err := l.Client.GetPlayerStats(allActivePlayers, currentWeek, l.Season)
+	playerStats, err := l.Client.GetPlayerStats(allActivePlayers, week, l.Season)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	projectedStatsByPlayer := make(map[string]StatsJSON)
 	actualStatsByPlayer := make(map[string]StatsJSON)
 	for _, as := range playerStats.Data.Actual {
 		actualStatsByPlayer[as.PlayerID] = as
 	}
-	for _, ps := range playerStats.Data.Projected {
-		projectedStatsByPlayer[ps.PlayerID] = ps
-	}
 
-	gamesByID, err := l.getGamesByID(currentWeek)
+	gamesByID, err := l.getGamesByID(week)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	projections := make([]MatchupProjection, 0)
-	for _, matchup := range matchups {
-		projection := 0.0
-		for _, startingPlayer := range matchup.Starters {
-			if projectedStats, ok := projectedStatsByPlayer[startingPlayer]; ok {
-				gameInfo := gamesByID[projectedStats.GameID]
-				projection += l.calculatePlayerProjection(actualStatsByPlayer[startingPlayer], projectedStats, gameInfo)
-			}
-		}
-		projections = append(projections, MatchupProjection{
-			Matchup:    matchup,
-			Projection: projection,
-		})
+	inputs := make(map[string]ProjectionInput)
+	for _, ps := range playerStats.Data.Projected {
+		inputs[ps.PlayerID] = l.projectionInput(ps.PlayerID, actualStatsByPlayer[ps.PlayerID], ps, gamesByID[ps.GameID])
 	}
-
-	return projections, nil
+	return matchups, inputs, nil
 }
 
 type gameMetadata struct {
 	status      string
 	secondsLeft int
+	// length of the game, including overtime if it's gone that far
+	gameSeconds int
+	homeTeam    string
+	awayTeam    string
 }
 
 func (l League) getGamesByID(week int) (map[string]gameMetadata, error) {
@@ -154,92 +196,109 @@ func (l League) getGamesByID(week int) (map[string]gameMetadata, error) {
 	if err != nil {
 		return nil, err
 	}
+	return gamesFromScores(batchScores)
+}
+
+func gamesFromScores(batchScores BatchScoresJSON) (map[string]gameMetadata, error) {
 	gamesByID := make(map[string]gameMetadata)
 
 	for _, game := range batchScores.Data.Scores {
 		var secondsLeft int
+		gameSeconds := regulationSeconds
 		if game.Status == "complete" {
 			secondsLeft = 0
 		} else if game.Status == "pre_game" {
-			secondsLeft = 3600
+			secondsLeft = regulationSeconds
+		} else if game.Metadata.QuarterNum == nil || game.Metadata.QuarterNum == "" {
+			// quarter_num is blank at halftime
+			secondsLeft = regulationSeconds / 2
 		} else {
-			// the quarter_num value is either an empty string or a number??? wtf sleeper
-			quarter := game.Metadata.QuarterNum
-			var quarterNum int
-			switch quarter := quarter.(type) {
-			case int:
-				quarterNum = quarter
-			case string:
-				val, err := strconv.Atoi(quarter)
+			quarterNum, err := quarterNumber(game.Metadata.QuarterNum)
+			if err != nil {
+				return nil, err
+			}
+			clockSeconds := 0
+			// time_remaining can also be blank between quarters, when there's no time left in the quarter anyway
+			if game.Metadata.TimeRemaining != "" {
+				quarterTimeRemaining := strings.Split(game.Metadata.TimeRemaining, ":")
+				if len(quarterTimeRemaining) != 2 {
+					return nil, fmt.Errorf("unexpected time_remaining value: %s", game.Metadata.TimeRemaining)
+				}
+				quarterMinsRemaining, err := strconv.Atoi(quarterTimeRemaining[0])
 				if err != nil {
 					return nil, err
 				}
-				quarterNum = val
-			default:
-				return nil, fmt.Errorf("unexpected quarter_num value: %s", quarter)
-			}
-			quartersLeft := 4 - quarterNum
-			// OT is represented as quarter 5. also there really isn't a math.max for integers in go???
-			if quartersLeft < 0 {
-				quartersLeft = 0
-			}
-			quarterTimeRemaining := strings.Split(game.Metadata.TimeRemaining, ":")
-			quarterMinsRemaining, err := strconv.Atoi(quarterTimeRemaining[0])
-			if err != nil {
-				return nil, err
+				quarterSecsRemaining, err := strconv.Atoi(quarterTimeRemaining[1])
+				if err != nil {
+					return nil, err
+				}
+				clockSeconds = (quarterMinsRemaining * 60) + quarterSecsRemaining
 			}
-			quarterSecsRemaining, err := strconv.Atoi(quarterTimeRemaining[1])
-			if err != nil {
-				return nil, err
+			if quarterNum > 4 {
+				// OT is represented as quarter 5, and the clock is the time left in the overtime period
+				gameSeconds = regulationSeconds + overtimeSeconds
+				secondsLeft = clockSeconds
+			} else {
+				secondsLeft = ((4 - quarterNum) * 15 * 60) + clockSeconds
 			}
-			secondsLeft = (quartersLeft * 15 * 60) + (quarterMinsRemaining * 60) + quarterSecsRemaining
 		}
 		gamesByID[game.GameID] = gameMetadata{
 			status:      game.Status,
 			secondsLeft: secondsLeft,
+			gameSeconds: gameSeconds,
+			homeTeam:    game.Metadata.HomeTeam,
+			awayTeam:    game.Metadata.AwayTeam,
 		}
 	}
 	return gamesByID, nil
 }
 
-func (l League) calculatePlayerProjection(actualStats StatsJSON, projectedStats StatsJSON, gameInfo gameMetadata) float64 {
-	originalProjection := l.scoreStats(projectedStats.Stats)
-	if gameInfo.status == "pre_game" {
-		return originalProjection
-	}
-	var currentScore float64
-	if actualStats.Stats == nil {
-		currentScore = 0.0
-	} else {
-		currentScore = l.scoreStats(actualStats.Stats)
-	}
-	if gameInfo.status == "complete" {
-		return currentScore
-	}
-
-	// else the game is in progress, let's calculate the projections on the fly
-	fractionalGameLeft := float64(gameInfo.secondsLeft) / 3600.0
-	minutesRemaining := float64(gameInfo.secondsLeft) / 60.0
-	// TODO: gotta see how overtime is handled
-	minutesPlayed := 60.0 - minutesRemaining
-
-	// this is all from the sleeper client
-	// TODO: think this is only the chunk that works for IDP, DEF roles had something else
-	s := currentScore + (currentScore / math.Max(minutesPlayed, 1.0) * minutesRemaining * (minutesRemaining / 60.0))
-	c := 0.2 * fractionalGameLeft * s
-	i := (.35 + .65*(1-fractionalGameLeft)) * s
-	u := .45 * fractionalGameLeft * s
-	d := math.Max(c+i+u, currentScore)
-	f := math.Max(originalProjection, currentScore)
-	return f + (1-fractionalGameLeft)*(d-f)
+// quarterNumber parses a game's quarter_num, where overtime is quarter 5.
+func quarterNumber(quarter interface{}) (int, error) {
+	// the quarter_num value is either an empty string or a number??? wtf sleeper
+	switch quarter := quarter.(type) {
+	case int:
+		return quarter, nil
+	case float64:
+		// encoding/json decodes every number in an interface{} as a float64
+		return int(quarter), nil
+	case string:
+		return strconv.Atoi(quarter)
+	default:
+		return 0, fmt.Errorf("unexpected quarter_num value: %v", quarter)
+	}
 }
 
-func (l League) scoreStats(stats map[string]float64) float64 {
-	total := 0.0
-	for statKey, statValue := range stats {
-		if statMultiplier, ok := l.LeagueInfo.ScoringSettings[statKey]; ok {
-			total += statMultiplier * statValue
+func (l League) projectionInput(playerID string, actualStats StatsJSON, projectedStats StatsJSON, gameInfo gameMetadata) ProjectionInput {
+	in := ProjectionInput{
+		PlayerID:    playerID,
+		Position:    l.Client.NFLPlayers[playerID].Position,
+		Projected:   l.scoreStats(playerID, projectedStats.Stats),
+		GameStatus:  gameInfo.status,
+		SecondsLeft: gameInfo.secondsLeft,
+		GameSeconds: gameInfo.gameSeconds,
+	}
+	if actualStats.Stats != nil {
+		in.Current = l.scoreStats(playerID, actualStats.Stats)
+	}
+	return in
+}
+
+// scoreStats scores a player's stat line with this league's scoring settings.
+func (l League) scoreStats(playerID string, stats map[string]float64) float64 {
+	return NewScorer(l.LeagueInfo.ScoringSettings).Score(stats, l.Client.NFLPlayers[playerID].Position)
+}
+
+// playedWeeks returns the number of loaded weeks that have any points scored, assuming weeks are played in order.
+func (l League) playedWeeks() int {
+	played := 0
+	for i, weekMatchups := range l.Matchups {
+		for _, m := range weekMatchups {
+			if m.Points != 0 {
+				played = i + 1
+				break
+			}
 		}
 	}
-	return total
+	return played
 }
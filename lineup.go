@@ -0,0 +1,299 @@
+package sleeper
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Sleeper uses "0" to mark an empty starting slot.
+const emptySlot = "0"
+
+// slotEligibility maps a roster slot to the fantasy positions that can fill it.
+// Slots not listed here only accept players of the same position.
+var slotEligibility = map[string][]string{
+	"FLEX":       {"RB", "WR", "TE"},
+	"WRRB_FLEX":  {"RB", "WR"},
+	"REC_FLEX":   {"WR", "TE"},
+	"SUPER_FLEX": {"QB", "RB", "WR", "TE"},
+	"IDP_FLEX":   {"DL", "LB", "DB"},
+	"DL":         {"DL", "DE", "DT"},
+	"DB":         {"DB", "CB", "S"},
+}
+
+// roster slots that don't count towards the starting lineup
+var nonStartingSlots = map[string]bool{
+	"BN":   true,
+	"IR":   true,
+	"TAXI": true,
+}
+
+// LineupSlot is a single starting slot in a lineup.
+type LineupSlot struct {
+	Position string
+	PlayerID string
+	Points   float64
+}
+
+// Lineup is a full set of starters, in the same order as the league's roster positions.
+type Lineup struct {
+	Slots  []LineupSlot
+	Points float64
+}
+
+// Starters returns the player IDs in the lineup, using "0" for any empty slots.
+func (l Lineup) Starters() []string {
+	starters := make([]string, len(l.Slots))
+	for i, s := range l.Slots {
+		starters[i] = s.PlayerID
+	}
+	return starters
+}
+
+// WeeklyEfficiency compares a team's actual score to its best possible score for one week.
+type WeeklyEfficiency struct {
+	Week          int
+	ActualPoints  float64
+	OptimalPoints float64
+	Efficiency    float64
+}
+
+// TeamEfficiency is a team's lineup efficiency over the season.
+type TeamEfficiency struct {
+	RosterID      int
+	Weeks         []WeeklyEfficiency
+	ActualPoints  float64
+	OptimalPoints float64
+	// Efficiency is actual points divided by optimal points (max points for).
+	Efficiency float64
+}
+
+// OptimalLineup returns the highest scoring legal lineup the given roster could have started in the given week.
+func (l League) OptimalLineup(rosterID int, week int) (Lineup, error) {
+	if week < 1 || week > len(l.Matchups) {
+		return Lineup{}, fmt.Errorf("no matchups loaded for week %d", week)
+	}
+	for _, m := range l.Matchups[week-1] {
+		if m.RosterID == rosterID {
+			return l.bestLineup(m.Players, m.PlayersPoints), nil
+		}
+	}
+	return Lineup{}, fmt.Errorf("no matchup found for roster %d in week %d", rosterID, week)
+}
+
+// ManagerEfficiency compares every team's actual starters to its optimal lineup for each week that has been played.
+// Teams are ordered from most to least efficient.
+func (l League) ManagerEfficiency() []TeamEfficiency {
+	byRoster := make(map[int]*TeamEfficiency)
+	for i := 0; i < l.playedWeeks(); i++ {
+		for _, m := range l.Matchups[i] {
+			te, ok := byRoster[m.RosterID]
+			if !ok {
+				te = &TeamEfficiency{RosterID: m.RosterID}
+				byRoster[m.RosterID] = te
+			}
+			actual := float64(m.Points)
+			optimal := l.bestLineup(m.Players, m.PlayersPoints).Points
+			te.Weeks = append(te.Weeks, WeeklyEfficiency{
+				Week:          i + 1,
+				ActualPoints:  actual,
+				OptimalPoints: optimal,
+				Efficiency:    efficiency(actual, optimal),
+			})
+			te.ActualPoints += actual
+			te.OptimalPoints += optimal
+		}
+	}
+
+	teams := make([]TeamEfficiency, 0, len(byRoster))
+	for _, te := range byRoster {
+		te.Efficiency = efficiency(te.ActualPoints, te.OptimalPoints)
+		teams = append(teams, *te)
+	}
+	sort.Slice(teams, func(i, j int) bool {
+		if teams[i].Efficiency != teams[j].Efficiency {
+			return teams[i].Efficiency > teams[j].Efficiency
+		}
+		return teams[i].RosterID < teams[j].RosterID
+	})
+	return teams
+}
+
+func efficiency(actual float64, optimal float64) float64 {
+	if optimal == 0 {
+		return 0
+	}
+	return actual / optimal
+}
+
+// startingSlots returns the roster positions that make up a starting lineup.
+func (l League) startingSlots() []string {
+	slots := make([]string, 0, len(l.LeagueInfo.RosterPositions))
+	for _, p := range l.LeagueInfo.RosterPositions {
+		if !nonStartingSlots[p] {
+			slots = append(slots, p)
+		}
+	}
+	return slots
+}
+
+// playerPositions returns the fantasy positions the given player is eligible for.
+func (l League) playerPositions(playerID string) []string {
+	info, ok := l.Client.NFLPlayers[playerID]
+	if !ok {
+		return nil
+	}
+	if len(info.FantasyPositions) > 0 {
+		return info.FantasyPositions
+	}
+	if info.Position != "" {
+		return []string{info.Position}
+	}
+	return nil
+}
+
+// playerTeam returns the NFL team the given player is on, or "" if they're a free agent.
+func (l League) playerTeam(playerID string) string {
+	return stringField(l.Client.NFLPlayers[playerID].Team)
+}
+
+// playerName returns the given player's name, falling back to their ID if they aren't in the players list.
+func (l League) playerName(playerID string) string {
+	return l.Client.PlayerName(playerID)
+}
+
+// canFill reports whether a player with the given positions can start in the given slot.
+func canFill(slot string, positions []string) bool {
+	eligible, ok := slotEligibility[slot]
+	if !ok {
+		eligible = []string{slot}
+	}
+	for _, p := range positions {
+		for _, e := range eligible {
+			if p == e {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// bestLineup fills the league's starting slots with the candidates that maximize total points.
+func (l League) bestLineup(candidates []string, points map[string]float64) Lineup {
+	return solveLineup(l.startingSlots(), candidates, points, l.playerPositions)
+}
+
+// solveLineup finds the point-maximizing assignment of candidates to slots.
+// Greedily filling slots breaks down when flex slots overlap without one containing the other (e.g. WRRB_FLEX and
+// REC_FLEX), so this is solved exactly as an assignment problem instead.
+func solveLineup(slots []string, candidates []string, points map[string]float64, positions func(string) []string) Lineup {
+	lineup := Lineup{Slots: make([]LineupSlot, len(slots))}
+	for i, s := range slots {
+		lineup.Slots[i] = LineupSlot{Position: s, PlayerID: emptySlot}
+	}
+	if len(slots) == 0 {
+		return lineup
+	}
+
+	// dedupe and drop empty placeholders
+	seen := make(map[string]bool)
+	players := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		if c == "" || c == emptySlot || seen[c] {
+			continue
+		}
+		seen[c] = true
+		players = append(players, c)
+	}
+
+	// columns are every candidate followed by one "leave it empty" column per slot
+	cost := make([][]float64, len(slots))
+	for i, s := range slots {
+		row := make([]float64, len(players)+len(slots))
+		for j, p := range players {
+			if canFill(s, positions(p)) {
+				// the small bonus makes a zero point player preferable to an empty slot
+				row[j] = -(points[p] + 1e-6)
+			} else {
+				row[j] = ineligibleCost
+			}
+		}
+		cost[i] = row
+	}
+
+	for i, col := range hungarian(cost) {
+		if col >= len(players) || cost[i][col] == ineligibleCost {
+			continue
+		}
+		p := players[col]
+		lineup.Slots[i].PlayerID = p
+		lineup.Slots[i].Points = points[p]
+		lineup.Points += points[p]
+	}
+	return lineup
+}
+
+const ineligibleCost = 1e9
+
+// hungarian solves the rectangular assignment problem for a cost matrix with no more rows than columns,
+// returning the column assigned to each row.
+func hungarian(cost [][]float64) []int {
+	n, m := len(cost), len(cost[0])
+	inf := ineligibleCost * float64(n+1)
+
+	u := make([]float64, n+1)
+	v := make([]float64, m+1)
+	p := make([]int, m+1)
+	way := make([]int, m+1)
+	for i := 1; i <= n; i++ {
+		p[0] = i
+		j0 := 0
+		minv := make([]float64, m+1)
+		for j := range minv {
+			minv[j] = inf
+		}
+		used := make([]bool, m+1)
+		for {
+			used[j0] = true
+			i0, delta, j1 := p[j0], inf, 0
+			for j := 1; j <= m; j++ {
+				if used[j] {
+					continue
+				}
+				cur := cost[i0-1][j-1] - u[i0] - v[j]
+				if cur < minv[j] {
+					minv[j] = cur
+					way[j] = j0
+				}
+				if minv[j] < delta {
+					delta = minv[j]
+					j1 = j
+				}
+			}
+			for j := 0; j <= m; j++ {
+				if used[j] {
+					u[p[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+			j0 = j1
+			if p[j0] == 0 {
+				break
+			}
+		}
+		for j0 != 0 {
+			j1 := way[j0]
+			p[j0] = p[j1]
+			j0 = j1
+		}
+	}
+
+	assignment := make([]int, n)
+	for j := 1; j <= m; j++ {
+		if p[j] != 0 {
+			assignment[p[j]-1] = j - 1
+		}
+	}
+	return assignment
+}
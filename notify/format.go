@@ -0,0 +1,256 @@
+package notify
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	sleeper "github.com/craigatron/sleeper-go"
+)
+
+// Formatter turns an event and its rendered message into a webhook request body.
+type Formatter interface {
+	Format(e sleeper.Event, message string) ([]byte, error)
+}
+
+// DiscordFormatter formats events as Discord embeds.
+type DiscordFormatter struct {
+	// Username overrides the webhook's default username if set.
+	Username string
+}
+
+// Format implements Formatter.
+func (f DiscordFormatter) Format(e sleeper.Event, message string) ([]byte, error) {
+	payload := map[string]interface{}{
+		"embeds": []map[string]interface{}{{
+			"title":       Title(e),
+			"description": message,
+			"color":       eventColor(e),
+			"timestamp":   time.Now().UTC().Format(time.RFC3339),
+		}},
+	}
+	if f.Username != "" {
+		payload["username"] = f.Username
+	}
+	return json.Marshal(payload)
+}
+
+// SlackFormatter formats events as Slack blocks.
+type SlackFormatter struct{}
+
+// Format implements Formatter.
+func (SlackFormatter) Format(e sleeper.Event, message string) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		// used for notifications and clients that can't show blocks
+		"text": fmt.Sprintf("%s: %s", Title(e), message),
+		"blocks": []map[string]interface{}{
+			{
+				"type": "header",
+				"text": map[string]string{"type": "plain_text", "text": Title(e)},
+			},
+			{
+				"type": "section",
+				"text": map[string]string{"type": "mrkdwn", "text": message},
+			},
+		},
+	})
+}
+
+// JSONFormatter sends the event as generic JSON, for webhooks that do their own formatting.
+type JSONFormatter struct{}
+
+// Format implements Formatter.
+func (JSONFormatter) Format(e sleeper.Event, message string) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"type":    e.EventType(),
+		"title":   Title(e),
+		"message": message,
+		"event":   e,
+		"time":    time.Now().UTC(),
+	})
+}
+
+// Title returns a short heading for the event.
+func Title(e sleeper.Event) string {
+	switch e.EventType() {
+	case sleeper.EventTypeTradeCompleted:
+		return "Trade completed"
+	case sleeper.EventTypeWaiverProcessed:
+		return "Waiver processed"
+	case sleeper.EventTypeFreeAgentAdded:
+		return "Free agent added"
+	case sleeper.EventTypeRosterChanged:
+		return "Roster changed"
+	case sleeper.EventTypeLineupChanged:
+		return "Lineup changed"
+	case sleeper.EventTypeScoreUpdated:
+		return "Score update"
+	case sleeper.EventTypeMatchupFinal:
+		return "Final score"
+	case sleeper.EventTypeGameKickoff:
+		return "Kickoff"
+	case sleeper.EventTypeQuarterChanged:
+		return "End of quarter"
+	case sleeper.EventTypeGameScoreChanged:
+		return "Score"
+	case sleeper.EventTypeRedZoneEntered:
+		return "Red zone"
+	case sleeper.EventTypePossessionChanged:
+		return "Change of possession"
+	case sleeper.EventTypeGameFinal:
+		return "Final"
+	case sleeper.EventTypePlayerTeamChanged:
+		return "Roster move"
+	case sleeper.EventTypePlayerStatusChanged:
+		return "Status change"
+	case sleeper.EventTypePlayerInjuryChanged:
+		return "Injury update"
+	case sleeper.EventTypePlayerDepthChartChanged:
+		return "Depth chart"
+	case sleeper.EventTypePlayerAdded:
+		return "New player"
+	}
+	return string(e.EventType())
+}
+
+// DefaultMessage returns a plain text description of the event.
+func DefaultMessage(e sleeper.Event) string {
+	switch e := e.(type) {
+	case sleeper.TradeCompleted:
+		return fmt.Sprintf("Rosters %s traded %s", joinInts(e.Transaction.RosterIDs), describeAdds(e.Transaction.Adds))
+	case sleeper.WaiverProcessed:
+		if e.Transaction.Status != "complete" {
+			return fmt.Sprintf("Waiver claim failed: %s", describeAdds(e.Transaction.Adds))
+		}
+		return fmt.Sprintf("Waiver claim won for $%d: %s", e.Transaction.Settings.WaiverBid, describeAdds(e.Transaction.Adds))
+	case sleeper.FreeAgentAdded:
+		return fmt.Sprintf("Free agent pickup: %s", describeAdds(e.Transaction.Adds))
+	case sleeper.RosterChanged:
+		moves := make([]string, 0, 2)
+		if len(e.Added) > 0 {
+			moves = append(moves, "added "+strings.Join(e.Added, ", "))
+		}
+		if len(e.Removed) > 0 {
+			moves = append(moves, "removed "+strings.Join(e.Removed, ", "))
+		}
+		return fmt.Sprintf("Roster %d %s", e.RosterID, strings.Join(moves, " and "))
+	case sleeper.LineupChanged:
+		return fmt.Sprintf("Roster %d changed its week %d lineup", e.RosterID, e.Week)
+	case sleeper.ScoreUpdated:
+		return fmt.Sprintf("Roster %d: %.2f → %.2f", e.RosterID, e.PreviousPoints, e.Points)
+	case sleeper.MatchupFinal:
+		return fmt.Sprintf("Week %d: roster %d %.2f, roster %d %.2f", e.Week,
+			e.Teams[0].RosterID, e.Teams[0].Points, e.Teams[1].RosterID, e.Teams[1].Points)
+	case sleeper.GameKickoff:
+		return fmt.Sprintf("%s @ %s is underway", e.Game.AwayTeam, e.Game.HomeTeam)
+	case sleeper.QuarterChanged:
+		if e.Quarter > 4 {
+			return fmt.Sprintf("%s heading to overtime", scoreline(e.Game))
+		}
+		return fmt.Sprintf("%s after %d", scoreline(e.Game), e.PreviousQuarter)
+	case sleeper.GameScoreChanged:
+		return scoreline(e.Game)
+	case sleeper.RedZoneEntered:
+		return fmt.Sprintf("%s in the red zone (%s)", e.Team, scoreline(e.Game))
+	case sleeper.PossessionChanged:
+		return fmt.Sprintf("%s ball (%s)", e.Possession, scoreline(e.Game))
+	case sleeper.GameFinal:
+		return fmt.Sprintf("Final: %s", scoreline(e.Game))
+	case sleeper.PlayerTeamChanged:
+		switch {
+		case e.PreviousTeam == "":
+			return fmt.Sprintf("%s signed with %s", playerLabel(e.Player), e.Player.Team)
+		case e.Player.Team == "":
+			return fmt.Sprintf("%s released by %s", playerLabel(e.Player), e.PreviousTeam)
+		}
+		return fmt.Sprintf("%s moved from %s to %s", playerLabel(e.Player), e.PreviousTeam, e.Player.Team)
+	case sleeper.PlayerStatusChanged:
+		if e.Status == e.PreviousStatus {
+			if e.Active {
+				return fmt.Sprintf("%s is now active", playerLabel(e.Player))
+			}
+			return fmt.Sprintf("%s is now inactive", playerLabel(e.Player))
+		}
+		return fmt.Sprintf("%s: %s → %s", playerLabel(e.Player), orNone(e.PreviousStatus), orNone(e.Status))
+	case sleeper.PlayerInjuryChanged:
+		if e.InjuryStatus == "" {
+			return fmt.Sprintf("%s is no longer listed as %s", playerLabel(e.Player), e.PreviousInjuryStatus)
+		}
+		if e.BodyPart != "" {
+			return fmt.Sprintf("%s is %s (%s)", playerLabel(e.Player), e.InjuryStatus, e.BodyPart)
+		}
+		return fmt.Sprintf("%s is %s", playerLabel(e.Player), e.InjuryStatus)
+	case sleeper.PlayerDepthChartChanged:
+		return fmt.Sprintf("%s: %s → %s", playerLabel(e.Player),
+			depthChartSpot(e.PreviousPosition, e.PreviousOrder), depthChartSpot(e.DepthPosition, e.Order))
+	case sleeper.PlayerAdded:
+		return fmt.Sprintf("%s added to the player list", playerLabel(e.Player))
+	}
+	return string(e.EventType())
+}
+
+// eventColor picks a Discord embed color for the event.
+func eventColor(e sleeper.Event) int {
+	switch e.EventType() {
+	case sleeper.EventTypeTradeCompleted:
+		return 0x5865f2
+	case sleeper.EventTypeWaiverProcessed, sleeper.EventTypeFreeAgentAdded:
+		return 0x57f287
+	case sleeper.EventTypeMatchupFinal, sleeper.EventTypeGameFinal:
+		return 0xfee75c
+	case sleeper.EventTypeRedZoneEntered, sleeper.EventTypePlayerInjuryChanged:
+		return 0xed4245
+	}
+	return 0x99aab5
+}
+
+func scoreline(g sleeper.GameInfo) string {
+	return fmt.Sprintf("%s %d, %s %d", g.AwayTeam, g.AwayScore, g.HomeTeam, g.HomeScore)
+}
+
+// playerLabel is a player's name with their position and team, e.g. "Patrick Mahomes (QB, KC)".
+func playerLabel(p sleeper.PlayerInfo) string {
+	details := make([]string, 0, 2)
+	for _, d := range []string{p.Position, p.Team} {
+		if d != "" {
+			details = append(details, d)
+		}
+	}
+	if len(details) == 0 {
+		return p.Name
+	}
+	return fmt.Sprintf("%s (%s)", p.Name, strings.Join(details, ", "))
+}
+
+func depthChartSpot(position string, order int) string {
+	if position == "" {
+		return "off the depth chart"
+	}
+	return fmt.Sprintf("%s%d", position, order)
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
+
+func describeAdds(adds map[string]int) string {
+	players := make([]string, 0, len(adds))
+	for playerID, rosterID := range adds {
+		players = append(players, fmt.Sprintf("%s to roster %d", playerID, rosterID))
+	}
+	sort.Strings(players)
+	return strings.Join(players, ", ")
+}
+
+func joinInts(ints []int) string {
+	parts := make([]string, len(ints))
+	for i, v := range ints {
+		parts[i] = fmt.Sprint(v)
+	}
+	return strings.Join(parts, " and ")
+}
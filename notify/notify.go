@@ -0,0 +1,239 @@
+// Package notify posts league, game and player events to webhooks like Discord and Slack.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	sleeper "github.com/craigatron/sleeper-go"
+)
+
+const (
+	defaultMaxRetries = 3
+	defaultRetryDelay = time.Second
+)
+
+// Webhook is a single destination for events.
+type Webhook struct {
+	// Name identifies the webhook in errors and the dead letter log. Webhook URLs usually contain a secret token, so
+	// they're never logged; if there's no name, the URL's host and a short hash of the URL are used instead.
+	Name      string
+	URL       string
+	Formatter Formatter
+	// Filter decides which events are sent to this webhook. A nil filter sends everything.
+	Filter func(sleeper.Event) bool
+	// Template renders the message text for each event, and is executed with a TemplateData. A nil template uses
+	// DefaultMessage.
+	Template *template.Template
+}
+
+// TemplateData is what a webhook's template is executed with.
+type TemplateData struct {
+	Type    sleeper.EventType
+	Event   sleeper.Event
+	Message string
+}
+
+// Notifier sends events to a set of webhooks, retrying failed deliveries.
+type Notifier struct {
+	Webhooks   []Webhook
+	HTTPClient *http.Client
+	// MaxRetries is how many times a failed delivery is retried. New sets this to 3.
+	MaxRetries int
+	// RetryDelay is the wait before the first retry, doubling with each attempt. Defaults to a second.
+	RetryDelay time.Duration
+	// DeadLetter receives a JSON line for every delivery that still failed after all its retries.
+	DeadLetter io.Writer
+
+	deadLetterMu sync.Mutex
+}
+
+// New creates a Notifier for the given webhooks with default retry settings.
+func New(webhooks ...Webhook) *Notifier {
+	return &Notifier{
+		Webhooks:   webhooks,
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+		MaxRetries: defaultMaxRetries,
+		RetryDelay: defaultRetryDelay,
+	}
+}
+
+// EventTypes returns a webhook filter that only lets through events of the given types.
+func EventTypes(types ...sleeper.EventType) func(sleeper.Event) bool {
+	allowed := make(map[sleeper.EventType]bool)
+	for _, t := range types {
+		allowed[t] = true
+	}
+	return func(e sleeper.Event) bool {
+		return allowed[e.EventType()]
+	}
+}
+
+// Callback returns a function that can be passed to a watcher's OnEvent. Failed deliveries end up in the dead
+// letter log.
+func (n *Notifier) Callback(ctx context.Context) func(sleeper.Event) {
+	return func(e sleeper.Event) {
+		_ = n.Notify(ctx, e)
+	}
+}
+
+// Notify sends the event to every webhook whose filter accepts it, returning an error if any delivery failed.
+func (n *Notifier) Notify(ctx context.Context, e sleeper.Event) error {
+	failures := make([]string, 0)
+	for _, w := range n.Webhooks {
+		if w.Filter != nil && !w.Filter(e) {
+			continue
+		}
+		if err := n.send(ctx, w, e); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", w.label(), err))
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to deliver %s event: %s", e.EventType(), strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+func (n *Notifier) send(ctx context.Context, w Webhook, e sleeper.Event) error {
+	message, err := renderMessage(w.Template, e)
+	if err != nil {
+		n.deadLetter(w, e, nil, err)
+		return err
+	}
+	formatter := w.Formatter
+	if formatter == nil {
+		formatter = JSONFormatter{}
+	}
+	body, err := formatter.Format(e, message)
+	if err != nil {
+		n.deadLetter(w, e, nil, err)
+		return err
+	}
+
+	delay := n.RetryDelay
+	if delay <= 0 {
+		delay = defaultRetryDelay
+	}
+	for attempt := 0; ; attempt++ {
+		retry, err := n.post(ctx, w.URL, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt >= n.MaxRetries {
+			n.deadLetter(w, e, body, err)
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			n.deadLetter(w, e, body, ctx.Err())
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// post sends a single request, and reports whether a failure is worth retrying.
+func (n *Notifier) post(ctx context.Context, target string, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", target, bytes.NewReader(body))
+	if err != nil {
+		return false, redactURL(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := n.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, redactURL(err)
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+		retry := res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= http.StatusInternalServerError
+		return retry, fmt.Errorf("unknown error, status code: %d", res.StatusCode)
+	}
+	return false, nil
+}
+
+// deadLetterEntry is a single line in the dead letter log.
+type deadLetterEntry struct {
+	Time      time.Time         `json:"time"`
+	Webhook   string            `json:"webhook"`
+	EventType sleeper.EventType `json:"event_type"`
+	Event     sleeper.Event     `json:"event"`
+	Body      json.RawMessage   `json:"body,omitempty"`
+	Error     string            `json:"error"`
+}
+
+func (n *Notifier) deadLetter(w Webhook, e sleeper.Event, body []byte, err error) {
+	if n.DeadLetter == nil {
+		return
+	}
+	entry := deadLetterEntry{
+		Time:      time.Now(),
+		Webhook:   w.label(),
+		EventType: e.EventType(),
+		Event:     e,
+		Error:     err.Error(),
+	}
+	if json.Valid(body) {
+		entry.Body = body
+	}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	n.deadLetterMu.Lock()
+	defer n.deadLetterMu.Unlock()
+	_, _ = n.DeadLetter.Write(append(line, '\n'))
+}
+
+// label is how the webhook is identified anywhere its URL might otherwise be logged.
+func (w Webhook) label() string {
+	if w.Name != "" {
+		return w.Name
+	}
+	sum := sha256.Sum256([]byte(w.URL))
+	host := "webhook"
+	if u, err := url.Parse(w.URL); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	return fmt.Sprintf("%s#%s", host, hex.EncodeToString(sum[:4]))
+}
+
+// redactURL strips the request URL out of errors from net/http, which would otherwise leak the webhook's token.
+func redactURL(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return fmt.Errorf("%s: %w", urlErr.Op, urlErr.Err)
+	}
+	return err
+}
+
+func renderMessage(t *template.Template, e sleeper.Event) (string, error) {
+	message := DefaultMessage(e)
+	if t == nil {
+		return message, nil
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, TemplateData{Type: e.EventType(), Event: e, Message: message}); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
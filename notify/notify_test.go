@@ -0,0 +1,222 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	sleeper "github.com/craigatron/sleeper-go"
+)
+
+// receiver is a webhook endpoint that answers with the given status codes in turn, then 204s.
+type receiver struct {
+	mu       sync.Mutex
+	statuses []int
+	bodies   [][]byte
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.bodies = append(r.bodies, body)
+	status := http.StatusNoContent
+	if len(r.statuses) > 0 {
+		status, r.statuses = r.statuses[0], r.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+func (r *receiver) requests() [][]byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.bodies
+}
+
+func newReceiver(t *testing.T, statuses ...int) (*receiver, *httptest.Server) {
+	r := &receiver{statuses: statuses}
+	srv := httptest.NewServer(r)
+	t.Cleanup(srv.Close)
+	return r, srv
+}
+
+func newTestNotifier(webhooks ...Webhook) *Notifier {
+	n := New(webhooks...)
+	n.RetryDelay = time.Millisecond
+	return n
+}
+
+var testEvent = sleeper.GameFinal{Game: sleeper.GameInfo{
+	GameID: "202310010", Week: 6, HomeTeam: "KC", AwayTeam: "DEN", HomeScore: 19, AwayScore: 8,
+}}
+
+func TestFormatters(t *testing.T) {
+	tests := []struct {
+		name      string
+		formatter Formatter
+		check     func(t *testing.T, body map[string]interface{})
+	}{
+		{"discord", DiscordFormatter{Username: "Scorebot"}, func(t *testing.T, body map[string]interface{}) {
+			if body["username"] != "Scorebot" {
+				t.Errorf("username = %v, want Scorebot", body["username"])
+			}
+			embeds, _ := body["embeds"].([]interface{})
+			if len(embeds) != 1 {
+				t.Fatalf("got %d embeds, want 1", len(embeds))
+			}
+			embed := embeds[0].(map[string]interface{})
+			if embed["title"] != "Final" || embed["description"] != "Final: DEN 8, KC 19" {
+				t.Errorf("embed = %v", embed)
+			}
+			if embed["color"] != float64(0xfee75c) {
+				t.Errorf("color = %v, want %v", embed["color"], 0xfee75c)
+			}
+		}},
+		{"slack", SlackFormatter{}, func(t *testing.T, body map[string]interface{}) {
+			if body["text"] != "Final: Final: DEN 8, KC 19" {
+				t.Errorf("text = %v", body["text"])
+			}
+			blocks, _ := body["blocks"].([]interface{})
+			if len(blocks) != 2 {
+				t.Fatalf("got %d blocks, want 2", len(blocks))
+			}
+			section := blocks[1].(map[string]interface{})["text"].(map[string]interface{})
+			if section["type"] != "mrkdwn" || section["text"] != "Final: DEN 8, KC 19" {
+				t.Errorf("section = %v", section)
+			}
+		}},
+		{"json", JSONFormatter{}, func(t *testing.T, body map[string]interface{}) {
+			if body["type"] != string(sleeper.EventTypeGameFinal) || body["message"] != "Final: DEN 8, KC 19" {
+				t.Errorf("body = %v", body)
+			}
+			event, _ := body["event"].(map[string]interface{})
+			game, _ := event["Game"].(map[string]interface{})
+			if game["GameID"] != "202310010" {
+				t.Errorf("event = %v", event)
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, srv := newReceiver(t)
+			n := newTestNotifier(Webhook{URL: srv.URL, Formatter: tt.formatter})
+			if err := n.Notify(context.Background(), testEvent); err != nil {
+				t.Fatal(err)
+			}
+			reqs := r.requests()
+			if len(reqs) != 1 {
+				t.Fatalf("got %d requests, want 1", len(reqs))
+			}
+			body := make(map[string]interface{})
+			if err := json.Unmarshal(reqs[0], &body); err != nil {
+				t.Fatal(err)
+			}
+			tt.check(t, body)
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	finals, finalsSrv := newReceiver(t)
+	trades, tradesSrv := newReceiver(t)
+	n := newTestNotifier(
+		Webhook{URL: finalsSrv.URL, Filter: EventTypes(sleeper.EventTypeGameFinal)},
+		Webhook{URL: tradesSrv.URL, Filter: EventTypes(sleeper.EventTypeTradeCompleted)},
+	)
+	if err := n.Notify(context.Background(), testEvent); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(finals.requests()); got != 1 {
+		t.Errorf("finals webhook got %d requests, want 1", got)
+	}
+	if got := len(trades.requests()); got != 0 {
+		t.Errorf("trades webhook got %d requests, want 0", got)
+	}
+}
+
+func TestRetries(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []int
+		wantErr  bool
+		attempts int
+	}{
+		{"success", nil, false, 1},
+		{"retries server errors", []int{500, 502}, false, 3},
+		{"retries rate limits", []int{429}, false, 2},
+		{"gives up after max retries", []int{500, 500, 500, 500}, true, 4},
+		{"no retry on bad request", []int{400}, true, 1},
+		{"no retry on not found", []int{404}, true, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, srv := newReceiver(t, tt.statuses...)
+			n := newTestNotifier(Webhook{URL: srv.URL})
+			err := n.Notify(context.Background(), testEvent)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Notify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := len(r.requests()); got != tt.attempts {
+				t.Errorf("got %d attempts, want %d", got, tt.attempts)
+			}
+		})
+	}
+}
+
+func TestDeadLetter(t *testing.T) {
+	_, srv := newReceiver(t, 400, 400)
+	secretURL := srv.URL + "/api/webhooks/123/secret-token"
+
+	var deadLetter bytes.Buffer
+	n := newTestNotifier(
+		Webhook{URL: secretURL, Formatter: SlackFormatter{}},
+		Webhook{Name: "league-chat", URL: secretURL},
+	)
+	n.DeadLetter = &deadLetter
+	err := n.Notify(context.Background(), testEvent)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if strings.Contains(err.Error(), "secret-token") {
+		t.Errorf("error leaks the webhook URL: %v", err)
+	}
+	if strings.Contains(deadLetter.String(), "secret-token") {
+		t.Errorf("dead letter log leaks the webhook URL: %s", deadLetter.String())
+	}
+
+	lines := strings.Split(strings.TrimSpace(deadLetter.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d dead letter lines, want 2", len(lines))
+	}
+	type entry struct {
+		Webhook   string            `json:"webhook"`
+		EventType sleeper.EventType `json:"event_type"`
+		Body      json.RawMessage   `json:"body"`
+		Error     string            `json:"error"`
+	}
+	entries := make([]entry, len(lines))
+	for i, line := range lines {
+		if err := json.Unmarshal([]byte(line), &entries[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if !strings.HasPrefix(entries[0].Webhook, "127.0.0.1:") || !strings.Contains(entries[0].Webhook, "#") {
+		t.Errorf("unnamed webhook is labelled %q, want host and hash", entries[0].Webhook)
+	}
+	if entries[1].Webhook != "league-chat" {
+		t.Errorf("named webhook is labelled %q, want league-chat", entries[1].Webhook)
+	}
+	for _, e := range entries {
+		if e.EventType != sleeper.EventTypeGameFinal || !strings.Contains(e.Error, "400") || len(e.Body) == 0 {
+			t.Errorf("dead letter entry = %+v", e)
+		}
+	}
+}
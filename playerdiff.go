@@ -0,0 +1,64 @@
+package sleeper
+
+import "sort"
+
+// DiffPlayers compares two snapshots of the NFL players list, like a Client's NFLPlayers from one day to the next,
+// and returns an event for every change: PlayerAdded, PlayerTeamChanged, PlayerStatusChanged, PlayerInjuryChanged
+// and PlayerDepthChartChanged. Events are ordered by player ID, and players who dropped out of the list are ignored.
+func DiffPlayers(old, new AllPlayersJSON) []Event {
+	ids := make([]string, 0, len(new))
+	for id := range new {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	events := make([]Event, 0)
+	for _, id := range ids {
+		current := new[id]
+		info := PlayerInfo{
+			PlayerID: id,
+			Name:     playerName(new, id),
+			Position: current.Position,
+			Team:     stringField(current.Team),
+		}
+		previous, ok := old[id]
+		if !ok {
+			events = append(events, PlayerAdded{Player: info})
+			continue
+		}
+
+		if team := stringField(previous.Team); team != info.Team {
+			events = append(events, PlayerTeamChanged{Player: info, PreviousTeam: team})
+		}
+		if previous.Status != current.Status || previous.Active != current.Active {
+			events = append(events, PlayerStatusChanged{
+				Player:         info,
+				PreviousStatus: previous.Status,
+				Status:         current.Status,
+				PreviousActive: previous.Active,
+				Active:         current.Active,
+			})
+		}
+		if injury := stringField(previous.InjuryStatus); injury != stringField(current.InjuryStatus) {
+			events = append(events, PlayerInjuryChanged{
+				Player:               info,
+				PreviousInjuryStatus: injury,
+				InjuryStatus:         stringField(current.InjuryStatus),
+				BodyPart:             stringField(current.InjuryBodyPart),
+			})
+		}
+		previousPosition, position := stringField(previous.DepthChartPosition), stringField(current.DepthChartPosition)
+		previousOrder, order := intField(previous.DepthChartOrder), intField(current.DepthChartOrder)
+		// a team change already covers leaving one depth chart for another
+		if (previousPosition != position || previousOrder != order) && stringField(previous.Team) == info.Team {
+			events = append(events, PlayerDepthChartChanged{
+				Player:           info,
+				PreviousPosition: previousPosition,
+				PreviousOrder:    previousOrder,
+				DepthPosition:    position,
+				Order:            order,
+			})
+		}
+	}
+	return events
+}
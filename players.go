@@ -0,0 +1,321 @@
+package sleeper
+
+import (
+	"sort"
+	"strings"
+)
+
+// PlayerFilter narrows down a list of players. The zero value matches everyone.
+type PlayerFilter struct {
+	// ActiveOnly leaves out players Sleeper doesn't consider active.
+	ActiveOnly bool
+	// Team limits results to a single NFL team, e.g. "KC".
+	Team string
+	// Positions limits results to players eligible at any of the given fantasy positions.
+	Positions []string
+}
+
+func (f PlayerFilter) matches(p PlayerInfoJSON) bool {
+	if f.ActiveOnly && !p.Active {
+		return false
+	}
+	if f.Team != "" && stringField(p.Team) != f.Team {
+		return false
+	}
+	if len(f.Positions) == 0 {
+		return true
+	}
+	for _, want := range f.Positions {
+		for _, have := range p.FantasyPositions {
+			if want == have {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// PlayerIndex looks up NFL players by name or by their IDs on other sites.
+type PlayerIndex struct {
+	// every player, ordered by search rank
+	players []PlayerInfoJSON
+	byID    map[string]int
+	// normalized full name and hashtag to positions in players
+	byName    map[string][]int
+	byHashtag map[string]int
+
+	byESPNID        map[int]int
+	byYahooID       map[int]int
+	bySportradarID  map[string]int
+	byRotowireID    map[int]int
+	byGSISID        map[string]int
+	byFantasyDataID map[int]int
+	byStatsID       map[string]int
+}
+
+// PlayerMatch is a single result from PlayerIndex.Search.
+type PlayerMatch struct {
+	Player PlayerInfoJSON
+	// Exact is true if the query was the player's full name, first or last name, or part of their full name.
+	Exact bool
+	// Distance is how many typos away the query is from the closest of the player's names.
+	Distance int
+}
+
+// NewPlayerIndex indexes every player in the given list, usually a Client's NFLPlayers.
+func NewPlayerIndex(players AllPlayersJSON) *PlayerIndex {
+	idx := &PlayerIndex{
+		players:         make([]PlayerInfoJSON, 0, len(players)),
+		byID:            make(map[string]int),
+		byName:          make(map[string][]int),
+		byHashtag:       make(map[string]int),
+		byESPNID:        make(map[int]int),
+		byYahooID:       make(map[int]int),
+		bySportradarID:  make(map[string]int),
+		byRotowireID:    make(map[int]int),
+		byGSISID:        make(map[string]int),
+		byFantasyDataID: make(map[int]int),
+		byStatsID:       make(map[string]int),
+	}
+	for id, p := range players {
+		if p.PlayerID == "" {
+			p.PlayerID = id
+		}
+		idx.players = append(idx.players, p)
+	}
+	sortBySearchRank(idx.players)
+
+	for i, p := range idx.players {
+		idx.byID[p.PlayerID] = i
+		name := playerSearchName(p)
+		idx.byName[name] = append(idx.byName[name], i)
+		if p.Hashtag != "" {
+			idx.byHashtag[strings.ToLower(p.Hashtag)] = i
+		}
+		// zero and blank IDs mean the player isn't on that site
+		if p.EspnID != 0 {
+			idx.byESPNID[p.EspnID] = i
+		}
+		if p.YahooID != 0 {
+			idx.byYahooID[p.YahooID] = i
+		}
+		if p.SportradarID != "" {
+			idx.bySportradarID[p.SportradarID] = i
+		}
+		if p.RotowireID != 0 {
+			idx.byRotowireID[p.RotowireID] = i
+		}
+		if p.FantasyDataID != 0 {
+			idx.byFantasyDataID[p.FantasyDataID] = i
+		}
+		if gsis := idField(p.GsisID); gsis != "" {
+			idx.byGSISID[gsis] = i
+		}
+		if stats := idField(p.StatsID); stats != "" {
+			idx.byStatsID[stats] = i
+		}
+	}
+	return idx
+}
+
+// Player returns the player with the given Sleeper ID.
+func (idx *PlayerIndex) Player(playerID string) (PlayerInfoJSON, bool) {
+	i, ok := idx.byID[playerID]
+	return idx.lookup(i, ok)
+}
+
+// Players returns every player matching the filter, ordered by search rank.
+func (idx *PlayerIndex) Players(filter PlayerFilter) []PlayerInfoJSON {
+	players := make([]PlayerInfoJSON, 0)
+	for _, p := range idx.players {
+		if filter.matches(p) {
+			players = append(players, p)
+		}
+	}
+	return players
+}
+
+// Find returns the players whose full name is exactly the given name, ignoring case, spaces and punctuation, ordered
+// by search rank. A hashtag like "#PatrickMahomes-NFL-KC-15" also works.
+func (idx *PlayerIndex) Find(name string) []PlayerInfoJSON {
+	players := make([]PlayerInfoJSON, 0)
+	if strings.HasPrefix(name, "#") {
+		if i, ok := idx.byHashtag[strings.ToLower(name)]; ok {
+			players = append(players, idx.players[i])
+		}
+		return players
+	}
+	for _, i := range idx.byName[normalizeName(name)] {
+		players = append(players, idx.players[i])
+	}
+	return players
+}
+
+// Search finds players matching free text, allowing for a typo every four letters or so. Exact matches come first,
+// then closer matches, then ties are broken by search rank. A limit of 0 or less returns every match.
+func (idx *PlayerIndex) Search(query string, filter PlayerFilter, limit int) []PlayerMatch {
+	normalized := normalizeName(query)
+	if normalized == "" {
+		return nil
+	}
+	maxDistance := len(normalized) / 4
+	// a single word could be a first or last name
+	singleWord := len(strings.Fields(query)) == 1
+
+	matches := make([]PlayerMatch, 0)
+	for _, p := range idx.players {
+		if !filter.matches(p) {
+			continue
+		}
+		names := []string{playerSearchName(p)}
+		if singleWord {
+			names = append(names, normalizeName(p.FirstName), normalizeName(p.LastName))
+		}
+
+		match := PlayerMatch{Player: p, Distance: maxDistance + 1}
+		for i, name := range names {
+			if name == "" {
+				continue
+			}
+			// part of a full name counts, but not just a few letters of a first or last name
+			if name == normalized || (i == 0 && len(normalized) >= 3 && strings.Contains(name, normalized)) {
+				match.Exact, match.Distance = true, 0
+				break
+			}
+			if d := levenshtein(normalized, name, maxDistance); d < match.Distance {
+				match.Distance = d
+			}
+		}
+		if match.Exact || match.Distance <= maxDistance {
+			matches = append(matches, match)
+		}
+	}
+
+	// players are already in search rank order, so a stable sort keeps that as the tiebreaker
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Exact != matches[j].Exact {
+			return matches[i].Exact
+		}
+		return matches[i].Distance < matches[j].Distance
+	})
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// ByESPNID returns the player with the given ESPN ID.
+func (idx *PlayerIndex) ByESPNID(id int) (PlayerInfoJSON, bool) {
+	i, ok := idx.byESPNID[id]
+	return idx.lookup(i, ok)
+}
+
+// ByYahooID returns the player with the given Yahoo ID.
+func (idx *PlayerIndex) ByYahooID(id int) (PlayerInfoJSON, bool) {
+	i, ok := idx.byYahooID[id]
+	return idx.lookup(i, ok)
+}
+
+// BySportradarID returns the player with the given Sportradar ID.
+func (idx *PlayerIndex) BySportradarID(id string) (PlayerInfoJSON, bool) {
+	i, ok := idx.bySportradarID[id]
+	return idx.lookup(i, ok)
+}
+
+// ByRotowireID returns the player with the given Rotowire ID.
+func (idx *PlayerIndex) ByRotowireID(id int) (PlayerInfoJSON, bool) {
+	i, ok := idx.byRotowireID[id]
+	return idx.lookup(i, ok)
+}
+
+// ByGSISID returns the player with the given NFL GSIS ID, e.g. "00-0033873".
+func (idx *PlayerIndex) ByGSISID(id string) (PlayerInfoJSON, bool) {
+	i, ok := idx.byGSISID[strings.TrimSpace(id)]
+	return idx.lookup(i, ok)
+}
+
+// ByFantasyDataID returns the player with the given FantasyData ID.
+func (idx *PlayerIndex) ByFantasyDataID(id int) (PlayerInfoJSON, bool) {
+	i, ok := idx.byFantasyDataID[id]
+	return idx.lookup(i, ok)
+}
+
+// ByStatsID returns the player with the given STATS ID.
+func (idx *PlayerIndex) ByStatsID(id string) (PlayerInfoJSON, bool) {
+	i, ok := idx.byStatsID[strings.TrimSpace(id)]
+	return idx.lookup(i, ok)
+}
+
+func (idx *PlayerIndex) lookup(i int, ok bool) (PlayerInfoJSON, bool) {
+	if !ok {
+		return PlayerInfoJSON{}, false
+	}
+	return idx.players[i], true
+}
+
+// playerSearchName is the player's normalized full name. Team defenses have no search name, so theirs is built from
+// their first and last name, e.g. "kansascitychiefs".
+func playerSearchName(p PlayerInfoJSON) string {
+	if p.SearchFullName != "" {
+		return p.SearchFullName
+	}
+	if p.FullName != "" {
+		return normalizeName(p.FullName)
+	}
+	return normalizeName(p.FirstName + p.LastName)
+}
+
+// normalizeName lowercases a name and strips everything but letters and digits, like Sleeper's search names.
+func normalizeName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// levenshtein returns the edit distance between a and b, or max+1 once it's clear the distance is more than max.
+func levenshtein(a, b string, max int) int {
+	if d := len(a) - len(b); d > max || -d > max {
+		return max + 1
+	}
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if curr[j] < rowMin {
+				rowMin = curr[j]
+			}
+		}
+		if rowMin > max {
+			return max + 1
+		}
+		prev, curr = curr, prev
+	}
+	if prev[len(b)] > max {
+		return max + 1
+	}
+	return prev[len(b)]
+}
+
+func minInt(first int, rest ...int) int {
+	m := first
+	for _, v := range rest {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,244 @@
+package sleeper
+
+import "math"
+
+// length of a regulation NFL game in seconds
+const regulationSeconds = 3600
+
+// length of a regular season overtime period in seconds
+const overtimeSeconds = 600
+
+// player positions that are scored as individual defensive players
+var idpPositions = map[string]bool{
+	"DL": true,
+	"DE": true,
+	"DT": true,
+	"LB": true,
+	"DB": true,
+	"CB": true,
+	"S":  true,
+}
+
+// ProjectionInput is everything a ProjectionModel gets to work with when projecting a player's final score.
+type ProjectionInput struct {
+	PlayerID string
+	Position string
+	// Projected is the player's pregame projected score.
+	Projected float64
+	// Current is the player's score so far.
+	Current float64
+	// GameStatus is Sleeper's status for the player's game: pre_game, in_game or complete.
+	GameStatus  string
+	SecondsLeft int
+	// GameSeconds is the length of the game, which is longer than regulation once it goes to overtime.
+	// Zero is treated as a regulation game.
+	GameSeconds int
+}
+
+func (in ProjectionInput) gameSeconds() float64 {
+	if in.GameSeconds <= 0 {
+		return regulationSeconds
+	}
+	return float64(in.GameSeconds)
+}
+
+// fractionLeft returns how much of the game is left, from 1 at kickoff to 0 at the final whistle.
+func (in ProjectionInput) fractionLeft() float64 {
+	return math.Min(math.Max(float64(in.SecondsLeft)/in.gameSeconds(), 0), 1)
+}
+
+// ProjectionModel projects a player's final score from their pregame projection and what they've done so far.
+type ProjectionModel interface {
+	ProjectPlayer(in ProjectionInput) float64
+}
+
+// SleeperProjectionModel is the blend of pregame projection and current pace that the Sleeper client uses.
+// Team defenses, kickers and IDPs don't score at a steady pace like offensive players, so they each get their own
+// projection path.
+type SleeperProjectionModel struct{}
+
+// ProjectPlayer implements ProjectionModel.
+func (m SleeperProjectionModel) ProjectPlayer(in ProjectionInput) float64 {
+	switch in.GameStatus {
+	case "pre_game":
+		return in.Projected
+	case "complete":
+		return in.Current
+	}
+
+	switch {
+	case in.Position == "DEF":
+		return m.projectDefense(in)
+	case in.Position == "K":
+		return m.projectKicker(in)
+	case idpPositions[in.Position]:
+		return m.projectIDP(in)
+	}
+	return m.projectOffense(in)
+}
+
+func (SleeperProjectionModel) projectOffense(in ProjectionInput) float64 {
+	fractionalGameLeft := in.fractionLeft()
+	minutesRemaining := float64(in.SecondsLeft) / 60.0
+	minutesPlayed := in.gameSeconds()/60.0 - minutesRemaining
+
+	// this is all from the sleeper client
+	s := in.Current + (in.Current / math.Max(minutesPlayed, 1.0) * minutesRemaining * (minutesRemaining / 60.0))
+	c := 0.2 * fractionalGameLeft * s
+	i := (.35 + .65*(1-fractionalGameLeft)) * s
+	u := .45 * fractionalGameLeft * s
+	d := math.Max(c+i+u, in.Current)
+	f := math.Max(in.Projected, in.Current)
+	return f + (1-fractionalGameLeft)*(d-f)
+}
+
+// projectDefense slides from the pregame projection towards the current score as the game goes on. Most of a
+// defense's score comes from points and yards allowed brackets, which only get worse as the game goes on, so
+// extrapolating the current pace badly overshoots.
+func (SleeperProjectionModel) projectDefense(in ProjectionInput) float64 {
+	return in.Projected + (1-in.fractionLeft())*(in.Current-in.Projected)
+}
+
+// projectKicker adds the unplayed share of the projection to the current score, since a couple of long field goals
+// early on says very little about the rest of the game.
+func (SleeperProjectionModel) projectKicker(in ProjectionInput) float64 {
+	return in.Current + in.Projected*in.fractionLeft()
+}
+
+// projectIDP blends two guesses at the rest of the game: the player's current pace carried through to the final
+// whistle, and the unplayed share of their projection. The pace gets more weight the longer the game has gone on.
+func (SleeperProjectionModel) projectIDP(in ProjectionInput) float64 {
+	fractionalGameLeft := in.fractionLeft()
+	fractionPlayed := 1 - fractionalGameLeft
+	if fractionPlayed <= 0 {
+		return in.Projected
+	}
+	paceRemaining := in.Current / fractionPlayed * fractionalGameLeft
+	projectedRemaining := in.Projected * fractionalGameLeft
+	return in.Current + fractionPlayed*paceRemaining + fractionalGameLeft*projectedRemaining
+}
+
+// LinearProjectionModel adds the unplayed share of the pregame projection to the player's current score.
+type LinearProjectionModel struct{}
+
+// ProjectPlayer implements ProjectionModel.
+func (LinearProjectionModel) ProjectPlayer(in ProjectionInput) float64 {
+	switch in.GameStatus {
+	case "pre_game":
+		return in.Projected
+	case "complete":
+		return in.Current
+	}
+	return in.Current + in.Projected*in.fractionLeft()
+}
+
+// ProjectionOnlyModel ignores live scoring entirely and always returns the pregame projection.
+// It's mostly useful as a baseline when comparing other models.
+type ProjectionOnlyModel struct{}
+
+// ProjectPlayer implements ProjectionModel.
+func (ProjectionOnlyModel) ProjectPlayer(in ProjectionInput) float64 {
+	return in.Projected
+}
+
+// ProjectionSample is a recorded projection input along with the score the player actually finished with.
+type ProjectionSample struct {
+	Input ProjectionInput
+	Final float64
+}
+
+// ProjectionAccuracy summarizes how close a model's projections were to final scores.
+type ProjectionAccuracy struct {
+	Samples int
+	// MeanError is the average of projected minus final, so a positive value means the model projects too high.
+	MeanError             float64
+	MeanAbsoluteError     float64
+	RootMeanSquaredError  float64
+	MaxAbsoluteError      float64
+	MaxAbsoluteErrorInput ProjectionInput
+}
+
+// EvaluateProjectionModel replays the given samples through a model and reports how accurate it was.
+func EvaluateProjectionModel(model ProjectionModel, samples []ProjectionSample) ProjectionAccuracy {
+	acc := ProjectionAccuracy{Samples: len(samples)}
+	if len(samples) == 0 {
+		return acc
+	}
+	var sum, sumAbs, sumSquares float64
+	for _, sample := range samples {
+		diff := model.ProjectPlayer(sample.Input) - sample.Final
+		sum += diff
+		sumAbs += math.Abs(diff)
+		sumSquares += diff * diff
+		if math.Abs(diff) > acc.MaxAbsoluteError {
+			acc.MaxAbsoluteError = math.Abs(diff)
+			acc.MaxAbsoluteErrorInput = sample.Input
+		}
+	}
+	n := float64(len(samples))
+	acc.MeanError = sum / n
+	acc.MeanAbsoluteError = sumAbs / n
+	acc.RootMeanSquaredError = math.Sqrt(sumSquares / n)
+	return acc
+}
+
+// ProjectionSamples builds projection samples from archived box scores: a batch_scores response and player stats
+// captured at the same point mid-week, plus the player stats once every game was final. Players are scored with
+// this league's scoring settings.
+func (l League) ProjectionSamples(scores BatchScoresJSON, snapshot PlayerStatsJSON, final PlayerStatsJSON) ([]ProjectionSample, error) {
+	gamesByID, err := gamesFromScores(scores)
+	if err != nil {
+		return nil, err
+	}
+
+	actualByPlayer := make(map[string]StatsJSON)
+	for _, as := range snapshot.Data.Actual {
+		actualByPlayer[as.PlayerID] = as
+	}
+	finalByPlayer := make(map[string]StatsJSON)
+	for _, fs := range final.Data.Actual {
+		finalByPlayer[fs.PlayerID] = fs
+	}
+
+	samples := make([]ProjectionSample, 0, len(snapshot.Data.Projected))
+	for _, ps := range snapshot.Data.Projected {
+		game, ok := gamesByID[ps.GameID]
+		if !ok {
+			continue
+		}
+		samples = append(samples, ProjectionSample{
+			Input: l.projectionInput(ps.PlayerID, actualByPlayer[ps.PlayerID], ps, game),
+			Final: l.scoreStats(ps.PlayerID, finalByPlayer[ps.PlayerID].Stats),
+		})
+	}
+	return samples, nil
+}
+
+// projectedPoints returns the pregame projected score of each of the given players for the given week. Players
+// without a projection (usually because they're on bye) are left out.
+func (l League) projectedPoints(players []string, week int) (map[string]float64, error) {
+	playerStats, err := l.Client.GetPlayerStats(players, week, l.Season)
+	if err != nil {
+		return nil, err
+	}
+	points := make(map[string]float64)
+	for _, ps := range playerStats.Data.Projected {
+		points[ps.PlayerID] = l.scoreStats(ps.PlayerID, ps.Stats)
+	}
+	return points, nil
+}
+
+// remainingWeeks returns every regular season week from the current NFL week on.
+func (l League) remainingWeeks() ([]int, error) {
+	state, err := l.Client.GetNflStatus()
+	if err != nil {
+		return nil, err
+	}
+	weeks := make([]int, 0)
+	for w := state.Week; w <= len(l.Matchups); w++ {
+		if w >= 1 {
+			weeks = append(weeks, w)
+		}
+	}
+	return weeks, nil
+}
@@ -0,0 +1,197 @@
+package sleeper
+
+import (
+	"math"
+	"testing"
+)
+
+// The box scores and stats in testdata are synthetic. They're in Sleeper's response format, but the games and stat
+// lines are made up to cover each game state: before kickoff, mid-quarter, halftime (when Sleeper blanks out
+// quarter_num), overtime and final.
+const (
+	fixturePreGame  = "pregame"
+	fixtureQ2       = "q2"
+	fixtureHalftime = "halftime"
+	fixtureOvertime = "overtime"
+	fixtureComplete = "complete"
+)
+
+func TestGamesFromScores(t *testing.T) {
+	scores := BatchScoresJSON{}
+	loadFixture(t, "batch_scores.json", &scores)
+
+	games, err := gamesFromScores(scores)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		gameID      string
+		status      string
+		secondsLeft int
+		gameSeconds int
+	}{
+		{fixturePreGame, "pre_game", 3600, 3600},
+		// 2 full quarters plus 7:30
+		{fixtureQ2, "in_game", 2250, 3600},
+		{fixtureHalftime, "in_game", 1800, 3600},
+		{fixtureOvertime, "in_game", 360, 4200},
+		{fixtureComplete, "complete", 0, 3600},
+	}
+	for _, tt := range tests {
+		game, ok := games[tt.gameID]
+		if !ok {
+			t.Errorf("game %s missing", tt.gameID)
+			continue
+		}
+		if game.status != tt.status || game.secondsLeft != tt.secondsLeft || game.gameSeconds != tt.gameSeconds {
+			t.Errorf("game %s = %s with %d of %d seconds left, want %s with %d of %d", tt.gameID, game.status,
+				game.secondsLeft, game.gameSeconds, tt.status, tt.secondsLeft, tt.gameSeconds)
+		}
+	}
+}
+
+func TestSleeperProjectionModel(t *testing.T) {
+	scores := BatchScoresJSON{}
+	loadFixture(t, "batch_scores.json", &scores)
+	games, err := gamesFromScores(scores)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// every case is a player projected for 10 who has 6 so far
+	const projected, current = 10.0, 6.0
+	tests := []struct {
+		name     string
+		position string
+		gameID   string
+		want     float64
+	}{
+		{"offense pre_game", "WR", fixturePreGame, 10},
+		{"offense Q2", "WR", fixtureQ2, 10.84375},
+		{"offense halftime", "WR", fixtureHalftime, 9.5},
+		{"offense OT", "WR", fixtureOvertime, 6.394285714285715},
+		{"offense complete", "WR", fixtureComplete, 6},
+
+		{"DEF pre_game", "DEF", fixturePreGame, 10},
+		{"DEF Q2", "DEF", fixtureQ2, 8.5},
+		{"DEF halftime", "DEF", fixtureHalftime, 8},
+		{"DEF OT", "DEF", fixtureOvertime, 6.342857142857143},
+		{"DEF complete", "DEF", fixtureComplete, 6},
+
+		{"K pre_game", "K", fixturePreGame, 10},
+		{"K Q2", "K", fixtureQ2, 12.25},
+		{"K halftime", "K", fixtureHalftime, 11},
+		{"K OT", "K", fixtureOvertime, 6.857142857142858},
+		{"K complete", "K", fixtureComplete, 6},
+
+		{"IDP pre_game", "LB", fixturePreGame, 10},
+		{"IDP Q2", "LB", fixtureQ2, 13.65625},
+		{"IDP halftime", "LB", fixtureHalftime, 11.5},
+		{"IDP OT", "LB", fixtureOvertime, 6.587755102040816},
+		{"IDP complete", "LB", fixtureComplete, 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := games[tt.gameID]
+			in := ProjectionInput{
+				PlayerID:    "1",
+				Position:    tt.position,
+				Projected:   projected,
+				Current:     current,
+				GameStatus:  game.status,
+				SecondsLeft: game.secondsLeft,
+				GameSeconds: game.gameSeconds,
+			}
+			if got := (SleeperProjectionModel{}).ProjectPlayer(in); !approx(got, tt.want) {
+				t.Errorf("ProjectPlayer() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateProjectionModel(t *testing.T) {
+	scores := BatchScoresJSON{}
+	loadFixture(t, "batch_scores.json", &scores)
+	snapshot, final := PlayerStatsJSON{}, PlayerStatsJSON{}
+	loadFixture(t, "stats_snapshot.json", &snapshot)
+	loadFixture(t, "stats_final.json", &final)
+
+	l := League{
+		Client: Client{NFLPlayers: AllPlayersJSON{
+			"qb1":  {Position: "QB"},
+			"wr1":  {Position: "WR"},
+			"def1": {Position: "DEF"},
+			"k1":   {Position: "K"},
+			"lb1":  {Position: "LB"},
+			"rb1":  {Position: "RB"},
+			"wr2":  {Position: "WR"},
+		}},
+	}
+	l.LeagueInfo.ScoringSettings = map[string]float64{
+		"pass_yd": 0.04, "pass_td": 4, "rush_yd": 0.1, "rush_td": 6, "rec": 1, "rec_yd": 0.1, "rec_td": 6,
+		"fgm": 3, "xpm": 1, "sack": 1, "int": 2, "idp_tkl_solo": 1, "idp_sack": 2,
+	}
+
+	samples, err := l.ProjectionSamples(scores, snapshot, final)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// wr2's game isn't in the batch scores, so there's no sample for them
+	if len(samples) != 6 {
+		t.Fatalf("got %d samples, want 6", len(samples))
+	}
+	byPlayer := make(map[string]ProjectionSample)
+	for _, s := range samples {
+		byPlayer[s.Input.PlayerID] = s
+	}
+	wantSamples := map[string]struct{ projected, current, final float64 }{
+		"qb1":  {21, 0, 26},
+		"wr1":  {15, 5, 21.5},
+		"def1": {5, 1, 6},
+		"k1":   {8, 4, 10},
+		"lb1":  {7, 8, 11},
+		"rb1":  {10, 15, 15},
+	}
+	for id, want := range wantSamples {
+		got := byPlayer[id]
+		if !approx(got.Input.Projected, want.projected) || !approx(got.Input.Current, want.current) || !approx(got.Final, want.final) {
+			t.Errorf("sample %s = %v/%v/%v, want %v/%v/%v", id, got.Input.Projected, got.Input.Current, got.Final,
+				want.projected, want.current, want.final)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		model ProjectionModel
+		want  ProjectionAccuracy
+	}{
+		{"sleeper", SleeperProjectionModel{}, ProjectionAccuracy{
+			Samples: 6, MeanError: -3.3432886904761907, MeanAbsoluteError: 3.3432886904761907,
+			RootMeanSquaredError: 4.26631490505574, MaxAbsoluteError: 8.296875,
+		}},
+		{"linear", LinearProjectionModel{}, ProjectionAccuracy{
+			Samples: 6, MeanError: -3.0666666666666664, MeanAbsoluteError: 3.0666666666666664,
+			RootMeanSquaredError: 3.8522990970761, MaxAbsoluteError: 7.125,
+		}},
+		{"projection only", ProjectionOnlyModel{}, ProjectionAccuracy{
+			Samples: 6, MeanError: -3.9166666666666665, MeanAbsoluteError: 3.9166666666666665,
+			RootMeanSquaredError: 4.344536799245692, MaxAbsoluteError: 6.5,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := EvaluateProjectionModel(tt.model, samples)
+			if got.Samples != tt.want.Samples || !approx(got.MeanError, tt.want.MeanError) ||
+				!approx(got.MeanAbsoluteError, tt.want.MeanAbsoluteError) ||
+				!approx(got.RootMeanSquaredError, tt.want.RootMeanSquaredError) ||
+				!approx(got.MaxAbsoluteError, tt.want.MaxAbsoluteError) {
+				t.Errorf("EvaluateProjectionModel() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func approx(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
@@ -0,0 +1,173 @@
+package sleeper
+
+import (
+	"fmt"
+	"sort"
+)
+
+// number of trailing weeks that feed into the recent form score
+const recentFormWeeks = 3
+
+// PowerRanking is a single team's entry in the league power rankings.
+type PowerRanking struct {
+	Rank     int
+	RosterID int
+
+	Wins      int
+	Losses    int
+	Ties      int
+	PointsFor float64
+
+	// all-play record is the record the team would have if it played every other team every week
+	AllPlayWins   int
+	AllPlayLosses int
+	AllPlayTies   int
+
+	// ExpectedWins is the sum of each week's all-play winning percentage.
+	ExpectedWins float64
+	// Luck is actual wins minus expected wins.
+	Luck float64
+	// RecentForm is a weighted average of the team's most recent scores, weighting later weeks more heavily.
+	RecentForm float64
+}
+
+// AllPlayPercentage returns the team's all-play winning percentage, counting ties as half a win.
+func (p PowerRanking) AllPlayPercentage() float64 {
+	games := p.AllPlayWins + p.AllPlayLosses + p.AllPlayTies
+	if games == 0 {
+		return 0
+	}
+	return (float64(p.AllPlayWins) + float64(p.AllPlayTies)/2) / float64(games)
+}
+
+// PowerRankings computes power rankings using every week up to and including the given week.
+// Teams are ordered by all-play winning percentage, with ties broken by recent form.
+func (l League) PowerRankings(week int) ([]PowerRanking, error) {
+	// future weeks are loaded with zero points, which would count as ties
+	if week < 1 || week > l.playedWeeks() {
+		return nil, fmt.Errorf("week %d hasn't been played", week)
+	}
+
+	rankingsByRoster := make(map[int]*PowerRanking)
+	scoresByRoster := make(map[int][]float64)
+	getRanking := func(rosterID int) *PowerRanking {
+		r, ok := rankingsByRoster[rosterID]
+		if !ok {
+			r = &PowerRanking{RosterID: rosterID}
+			rankingsByRoster[rosterID] = r
+		}
+		return r
+	}
+
+	for _, weekMatchups := range l.Matchups[:week] {
+		for _, m := range weekMatchups {
+			r := getRanking(m.RosterID)
+			points := float64(m.Points)
+			r.PointsFor += points
+			scoresByRoster[m.RosterID] = append(scoresByRoster[m.RosterID], points)
+
+			weekWins, weekLosses, weekTies := 0, 0, 0
+			for _, other := range weekMatchups {
+				if other.RosterID == m.RosterID {
+					continue
+				}
+				switch {
+				case m.Points > other.Points:
+					weekWins++
+				case m.Points < other.Points:
+					weekLosses++
+				default:
+					weekTies++
+				}
+			}
+			r.AllPlayWins += weekWins
+			r.AllPlayLosses += weekLosses
+			r.AllPlayTies += weekTies
+			if opponents := weekWins + weekLosses + weekTies; opponents > 0 {
+				r.ExpectedWins += (float64(weekWins) + float64(weekTies)/2) / float64(opponents)
+			}
+		}
+
+		for _, pair := range pairMatchups(weekMatchups) {
+			a, b := getRanking(pair[0].RosterID), getRanking(pair[1].RosterID)
+			switch {
+			case pair[0].Points > pair[1].Points:
+				a.Wins++
+				b.Losses++
+			case pair[0].Points < pair[1].Points:
+				a.Losses++
+				b.Wins++
+			default:
+				a.Ties++
+				b.Ties++
+			}
+		}
+	}
+
+	rankings := make([]PowerRanking, 0, len(rankingsByRoster))
+	for rosterID, r := range rankingsByRoster {
+		r.Luck = float64(r.Wins) + float64(r.Ties)/2 - r.ExpectedWins
+		r.RecentForm = recentForm(scoresByRoster[rosterID])
+		rankings = append(rankings, *r)
+	}
+
+	sort.Slice(rankings, func(i, j int) bool {
+		pi, pj := rankings[i].AllPlayPercentage(), rankings[j].AllPlayPercentage()
+		if pi != pj {
+			return pi > pj
+		}
+		if rankings[i].RecentForm != rankings[j].RecentForm {
+			return rankings[i].RecentForm > rankings[j].RecentForm
+		}
+		return rankings[i].RosterID < rankings[j].RosterID
+	})
+	for i := range rankings {
+		rankings[i].Rank = i + 1
+	}
+
+	return rankings, nil
+}
+
+// recentForm is a linearly weighted average of the last few scores, so the most recent week counts the most.
+func recentForm(scores []float64) float64 {
+	if len(scores) > recentFormWeeks {
+		scores = scores[len(scores)-recentFormWeeks:]
+	}
+	total, weights := 0.0, 0.0
+	for i, s := range scores {
+		weight := float64(i + 1)
+		total += weight * s
+		weights += weight
+	}
+	if weights == 0 {
+		return 0
+	}
+	return total / weights
+}
+
+// pairMatchups groups a week's matchups into head-to-head pairs by matchup ID.
+// Teams without a matchup ID (e.g. byes in the playoffs) or without an opponent are skipped.
+func pairMatchups(matchups MatchupsJSON) [][2]MatchupJSON {
+	byMatchupID := make(map[int][]MatchupJSON)
+	ids := make([]int, 0)
+	for _, m := range matchups {
+		if m.MatchupID == 0 {
+			continue
+		}
+		if _, ok := byMatchupID[m.MatchupID]; !ok {
+			ids = append(ids, m.MatchupID)
+		}
+		byMatchupID[m.MatchupID] = append(byMatchupID[m.MatchupID], m)
+	}
+	sort.Ints(ids)
+
+	pairs := make([][2]MatchupJSON, 0, len(ids))
+	for _, id := range ids {
+		teams := byMatchupID[id]
+		if len(teams) != 2 {
+			continue
+		}
+		pairs = append(pairs, [2]MatchupJSON{teams[0], teams[1]})
+	}
+	return pairs
+}
@@ -0,0 +1,32 @@
+package sleeper
+
+import "testing"
+
+func TestPowerRankingsIgnoresUnplayedWeeks(t *testing.T) {
+	l := League{Matchups: []MatchupsJSON{
+		{{RosterID: 1, MatchupID: 1, Points: 100}, {RosterID: 2, MatchupID: 1, Points: 90}},
+		{{RosterID: 1, MatchupID: 1, Points: 80}, {RosterID: 2, MatchupID: 1, Points: 120}},
+		// the rest of the regular season is loaded but hasn't been played
+		{{RosterID: 1, MatchupID: 1}, {RosterID: 2, MatchupID: 1}},
+		{{RosterID: 1, MatchupID: 1}, {RosterID: 2, MatchupID: 1}},
+	}}
+
+	for _, week := range []int{0, 3, 4} {
+		if _, err := l.PowerRankings(week); err == nil {
+			t.Errorf("PowerRankings(%d) didn't return an error", week)
+		}
+	}
+
+	rankings, err := l.PowerRankings(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range rankings {
+		if r.Wins != 1 || r.Losses != 1 || r.Ties != 0 {
+			t.Errorf("roster %d is %d-%d-%d, want 1-1-0", r.RosterID, r.Wins, r.Losses, r.Ties)
+		}
+		if r.ExpectedWins != 1 {
+			t.Errorf("roster %d has %v expected wins, want 1", r.RosterID, r.ExpectedWins)
+		}
+	}
+}
@@ -0,0 +1,356 @@
+package sleeper
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"sort"
+	"strings"
+)
+
+// PlayerScore is a single player's points for a week.
+type PlayerScore struct {
+	PlayerID string
+	Name     string
+	Points   float64
+}
+
+// RecapTeam is one team's week in a recap.
+type RecapTeam struct {
+	RosterID  int
+	TeamName  string
+	Points    float64
+	TopScorer PlayerScore
+	// BenchBlunder is the best bench player who should have started, or nil if the team started its optimal lineup.
+	BenchBlunder *PlayerScore
+	// PointsLeftOnBench is the difference between the team's optimal lineup and what it actually scored.
+	PointsLeftOnBench float64
+}
+
+// RecapMatchup is the result of a single matchup. For ties, the winner is just the first team.
+type RecapMatchup struct {
+	MatchupID int
+	Winner    RecapTeam
+	Loser     RecapTeam
+	Margin    float64
+	Tie       bool
+}
+
+// RecapTransaction is a completed transaction, described for humans.
+type RecapTransaction struct {
+	Type        string
+	Description string
+}
+
+// RecapStanding is a team's place in the standings after the week, and how it moved.
+type RecapStanding struct {
+	Standing
+	TeamName     string
+	PreviousRank int
+	// Movement is how many places the team climbed, or negative if it fell.
+	Movement int
+}
+
+// WeeklyRecap is everything that happened in a league in a given week.
+type WeeklyRecap struct {
+	LeagueName   string
+	Week         int
+	Matchups     []RecapMatchup
+	HighScore    RecapTeam
+	LowScore     RecapTeam
+	Transactions []RecapTransaction
+	Standings    []RecapStanding
+}
+
+// WeeklyRecap builds a recap of the given week: results, standout performances, transactions and standings.
+func (l League) WeeklyRecap(week int) (WeeklyRecap, error) {
+	recap := WeeklyRecap{LeagueName: l.LeagueInfo.Name, Week: week}
+	if week < 1 || week > len(l.Matchups) {
+		return recap, fmt.Errorf("no matchups loaded for week %d", week)
+	}
+	weekMatchups := l.Matchups[week-1]
+
+	teams := make(map[int]RecapTeam)
+	for i, m := range weekMatchups {
+		team := l.recapTeam(m)
+		teams[m.RosterID] = team
+		if i == 0 || team.Points > recap.HighScore.Points {
+			recap.HighScore = team
+		}
+		if i == 0 || team.Points < recap.LowScore.Points {
+			recap.LowScore = team
+		}
+	}
+
+	for _, pair := range pairMatchups(weekMatchups) {
+		a, b := teams[pair[0].RosterID], teams[pair[1].RosterID]
+		if a.Points < b.Points {
+			a, b = b, a
+		}
+		recap.Matchups = append(recap.Matchups, RecapMatchup{
+			MatchupID: pair[0].MatchupID,
+			Winner:    a,
+			Loser:     b,
+			Margin:    a.Points - b.Points,
+			Tie:       a.Points == b.Points,
+		})
+	}
+
+	transactions, err := l.Client.GetLeagueTransactions(l.ID, week)
+	if err != nil {
+		return recap, err
+	}
+	sort.SliceStable(transactions, func(i, j int) bool { return transactions[i].StatusUpdated < transactions[j].StatusUpdated })
+	for _, tx := range transactions {
+		if tx.Status != "complete" {
+			continue
+		}
+		recap.Transactions = append(recap.Transactions, RecapTransaction{
+			Type:        tx.Type,
+			Description: l.describeTransaction(tx),
+		})
+	}
+
+	previousRanks := make(map[int]int)
+	for _, s := range computeStandings(l.Matchups[:week-1], matchupPoints) {
+		previousRanks[s.RosterID] = s.Rank
+	}
+	for _, s := range computeStandings(l.Matchups[:week], matchupPoints) {
+		rs := RecapStanding{
+			Standing:     s,
+			TeamName:     l.TeamName(s.RosterID),
+			PreviousRank: previousRanks[s.RosterID],
+		}
+		if rs.PreviousRank != 0 {
+			rs.Movement = rs.PreviousRank - s.Rank
+		}
+		recap.Standings = append(recap.Standings, rs)
+	}
+
+	return recap, nil
+}
+
+func (l League) recapTeam(m MatchupJSON) RecapTeam {
+	team := RecapTeam{
+		RosterID: m.RosterID,
+		TeamName: l.TeamName(m.RosterID),
+		Points:   float64(m.Points),
+	}
+
+	starting := make(map[string]bool)
+	for i, p := range m.Starters {
+		starting[p] = true
+		points := m.PlayersPoints[p]
+		if i < len(m.StartersPoints) {
+			points = m.StartersPoints[i]
+		}
+		if p != emptySlot && (team.TopScorer.PlayerID == "" || points > team.TopScorer.Points) {
+			team.TopScorer = PlayerScore{PlayerID: p, Name: l.playerName(p), Points: points}
+		}
+	}
+
+	optimal := l.bestLineup(m.Players, m.PlayersPoints)
+	team.PointsLeftOnBench = optimal.Points - team.Points
+	if team.PointsLeftOnBench < 0 {
+		// custom points from the commissioner can put actual scores over the optimal lineup
+		team.PointsLeftOnBench = 0
+	}
+	for _, s := range optimal.Slots {
+		if s.PlayerID == emptySlot || starting[s.PlayerID] {
+			continue
+		}
+		if team.BenchBlunder == nil || s.Points > team.BenchBlunder.Points {
+			team.BenchBlunder = &PlayerScore{PlayerID: s.PlayerID, Name: l.playerName(s.PlayerID), Points: s.Points}
+		}
+	}
+	return team
+}
+
+func (l League) describeTransaction(tx TransactionJSON) string {
+	parts := make([]string, 0)
+	for _, rosterID := range tx.RosterIDs {
+		adds, drops := make([]string, 0), make([]string, 0)
+		for p, r := range tx.Adds {
+			if r == rosterID {
+				adds = append(adds, l.playerName(p))
+			}
+		}
+		for p, r := range tx.Drops {
+			if r == rosterID {
+				drops = append(drops, l.playerName(p))
+			}
+		}
+		sort.Strings(adds)
+		sort.Strings(drops)
+
+		moves := make([]string, 0, 2)
+		if len(adds) > 0 {
+			add := "added " + strings.Join(adds, ", ")
+			if tx.Type == "waiver" && tx.Settings.WaiverBid > 0 {
+				add += fmt.Sprintf(" ($%d)", tx.Settings.WaiverBid)
+			}
+			moves = append(moves, add)
+		}
+		if len(drops) > 0 {
+			moves = append(moves, "dropped "+strings.Join(drops, ", "))
+		}
+		if len(moves) > 0 {
+			parts = append(parts, fmt.Sprintf("%s %s", l.TeamName(rosterID), strings.Join(moves, " and ")))
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
+func (r WeeklyRecap) title() string {
+	if r.LeagueName == "" {
+		return fmt.Sprintf("Week %d Recap", r.Week)
+	}
+	return fmt.Sprintf("%s: Week %d Recap", r.LeagueName, r.Week)
+}
+
+func (m RecapMatchup) verb() string {
+	if m.Tie {
+		return "tied"
+	}
+	return "beat"
+}
+
+func (s RecapStanding) movement() string {
+	switch {
+	case s.PreviousRank == 0 || s.Movement == 0:
+		return "-"
+	case s.Movement > 0:
+		return fmt.Sprintf("▲%d", s.Movement)
+	}
+	return fmt.Sprintf("▼%d", -s.Movement)
+}
+
+func (t RecapTeam) blunder() string {
+	if t.BenchBlunder == nil {
+		return "none"
+	}
+	return fmt.Sprintf("%s (%.2f) sat on the bench, %.2f points left there", t.BenchBlunder.Name, t.BenchBlunder.Points, t.PointsLeftOnBench)
+}
+
+// Text renders the recap as plain text.
+func (r WeeklyRecap) Text() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n\n", r.title())
+
+	b.WriteString("RESULTS\n")
+	for _, m := range r.Matchups {
+		fmt.Fprintf(&b, "%s %s %s, %.2f-%.2f (margin %.2f)\n", m.Winner.TeamName, m.verb(), m.Loser.TeamName, m.Winner.Points, m.Loser.Points, m.Margin)
+		for _, t := range []RecapTeam{m.Winner, m.Loser} {
+			fmt.Fprintf(&b, "  %s: top scorer %s (%.2f), bench blunder: %s\n", t.TeamName, t.TopScorer.Name, t.TopScorer.Points, t.blunder())
+		}
+	}
+
+	fmt.Fprintf(&b, "\nHigh score: %s (%.2f)\n", r.HighScore.TeamName, r.HighScore.Points)
+	fmt.Fprintf(&b, "Low score: %s (%.2f)\n", r.LowScore.TeamName, r.LowScore.Points)
+
+	if len(r.Transactions) > 0 {
+		b.WriteString("\nTRANSACTIONS\n")
+		for _, t := range r.Transactions {
+			fmt.Fprintf(&b, "[%s] %s\n", t.Type, t.Description)
+		}
+	}
+
+	b.WriteString("\nSTANDINGS\n")
+	for _, s := range r.Standings {
+		fmt.Fprintf(&b, "%2d. %s %d-%d-%d, %.2f PF (%s)\n", s.Rank, s.TeamName, s.Wins, s.Losses, s.Ties, s.PointsFor, s.movement())
+	}
+	return b.String()
+}
+
+// Markdown renders the recap as Markdown.
+func (r WeeklyRecap) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", r.title())
+
+	b.WriteString("## Results\n\n")
+	for _, m := range r.Matchups {
+		fmt.Fprintf(&b, "**%s** %s **%s**, %.2f-%.2f (margin %.2f)\n\n", m.Winner.TeamName, m.verb(), m.Loser.TeamName, m.Winner.Points, m.Loser.Points, m.Margin)
+		for _, t := range []RecapTeam{m.Winner, m.Loser} {
+			fmt.Fprintf(&b, "- %s: top scorer %s (%.2f), bench blunder: %s\n", t.TeamName, t.TopScorer.Name, t.TopScorer.Points, t.blunder())
+		}
+		b.WriteString("\n")
+	}
+
+	fmt.Fprintf(&b, "**High score:** %s (%.2f)  \n", r.HighScore.TeamName, r.HighScore.Points)
+	fmt.Fprintf(&b, "**Low score:** %s (%.2f)\n\n", r.LowScore.TeamName, r.LowScore.Points)
+
+	if len(r.Transactions) > 0 {
+		b.WriteString("## Transactions\n\n")
+		for _, t := range r.Transactions {
+			fmt.Fprintf(&b, "- *%s*: %s\n", t.Type, t.Description)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## Standings\n\n| Rank | Team | Record | PF | Move |\n| --- | --- | --- | --- | --- |\n")
+	for _, s := range r.Standings {
+		fmt.Fprintf(&b, "| %d | %s | %d-%d-%d | %.2f | %s |\n", s.Rank, s.TeamName, s.Wins, s.Losses, s.Ties, s.PointsFor, s.movement())
+	}
+	return b.String()
+}
+
+var recapHTMLTemplate = template.Must(template.New("recap").Parse(`<h1>{{.Title}}</h1>
+<h2>Results</h2>
+{{range .Matchups}}<div class="matchup">
+<p><strong>{{.Winner.TeamName}}</strong> {{.Verb}} <strong>{{.Loser.TeamName}}</strong>, {{printf "%.2f" .Winner.Points}}-{{printf "%.2f" .Loser.Points}} (margin {{printf "%.2f" .Margin}})</p>
+<ul>
+<li>{{.Winner.TeamName}}: top scorer {{.Winner.TopScorer.Name}} ({{printf "%.2f" .Winner.TopScorer.Points}}), bench blunder: {{.WinnerBlunder}}</li>
+<li>{{.Loser.TeamName}}: top scorer {{.Loser.TopScorer.Name}} ({{printf "%.2f" .Loser.TopScorer.Points}}), bench blunder: {{.LoserBlunder}}</li>
+</ul>
+</div>
+{{end}}<p><strong>High score:</strong> {{.HighScore.TeamName}} ({{printf "%.2f" .HighScore.Points}})<br>
+<strong>Low score:</strong> {{.LowScore.TeamName}} ({{printf "%.2f" .LowScore.Points}})</p>
+{{if .Transactions}}<h2>Transactions</h2>
+<ul>
+{{range .Transactions}}<li><em>{{.Type}}</em>: {{.Description}}</li>
+{{end}}</ul>
+{{end}}<h2>Standings</h2>
+<table>
+<tr><th>Rank</th><th>Team</th><th>Record</th><th>PF</th><th>Move</th></tr>
+{{range .Standings}}<tr><td>{{.Rank}}</td><td>{{.TeamName}}</td><td>{{.Wins}}-{{.Losses}}-{{.Ties}}</td><td>{{printf "%.2f" .PointsFor}}</td><td>{{.Move}}</td></tr>
+{{end}}</table>
+`))
+
+// HTML renders the recap as an HTML fragment.
+func (r WeeklyRecap) HTML() (string, error) {
+	type htmlMatchup struct {
+		RecapMatchup
+		Verb          string
+		WinnerBlunder string
+		LoserBlunder  string
+	}
+	type htmlStanding struct {
+		RecapStanding
+		Move string
+	}
+	data := struct {
+		Title        string
+		Matchups     []htmlMatchup
+		HighScore    RecapTeam
+		LowScore     RecapTeam
+		Transactions []RecapTransaction
+		Standings    []htmlStanding
+	}{
+		Title:        r.title(),
+		HighScore:    r.HighScore,
+		LowScore:     r.LowScore,
+		Transactions: r.Transactions,
+	}
+	for _, m := range r.Matchups {
+		data.Matchups = append(data.Matchups, htmlMatchup{RecapMatchup: m, Verb: m.verb(), WinnerBlunder: m.Winner.blunder(), LoserBlunder: m.Loser.blunder()})
+	}
+	for _, s := range r.Standings {
+		data.Standings = append(data.Standings, htmlStanding{RecapStanding: s, Move: s.movement()})
+	}
+
+	var buf bytes.Buffer
+	if err := recapHTMLTemplate.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,203 @@
+package sleeper
+
+import "fmt"
+
+// ExclusionReason is why a player was left out of a lineup recommendation.
+type ExclusionReason string
+
+const (
+	// ExclusionReasonBye is for players whose team isn't playing this week.
+	ExclusionReasonBye ExclusionReason = "bye"
+	// ExclusionReasonInjured is for players ruled out or on IR.
+	ExclusionReasonInjured ExclusionReason = "injured"
+	// ExclusionReasonLocked is for bench players whose game has already started.
+	ExclusionReasonLocked ExclusionReason = "locked"
+)
+
+// injury statuses that mean a player definitely won't play
+var inactiveInjuryStatuses = map[string]bool{
+	"Out": true,
+	"IR":  true,
+}
+
+// ExcludedPlayer is a rostered player that can't be moved into the lineup.
+type ExcludedPlayer struct {
+	PlayerID string
+	Reason   ExclusionReason
+}
+
+// LineupSwap is a single suggested lineup change.
+type LineupSwap struct {
+	Slot string
+	// Out is the player currently in the slot, or "0" if it's empty.
+	Out string
+	In  string
+	// Gain is the projected points gained by making the swap.
+	Gain float64
+}
+
+// LineupRecommendation is the projection-maximizing lineup for a roster in the current week.
+type LineupRecommendation struct {
+	Week        int
+	Current     Lineup
+	Recommended Lineup
+	Swaps       []LineupSwap
+	Excluded    []ExcludedPlayer
+}
+
+// RecommendLineup suggests the lineup with the highest projected score for the given roster this week.
+// Players on bye, ruled out, or on the bench with a game that has already started are never recommended, and
+// starters whose games have started are kept where they are.
+func (l League) RecommendLineup(rosterID int) (LineupRecommendation, error) {
+	state, err := l.Client.GetNflStatus()
+	if err != nil {
+		return LineupRecommendation{}, err
+	}
+	week := state.Week
+	rec := LineupRecommendation{Week: week}
+
+	matchups, err := l.Client.GetLeagueMatchups(l.ID, week)
+	if err != nil {
+		return rec, err
+	}
+	var players, starters []string
+	for _, m := range matchups {
+		if m.RosterID == rosterID {
+			players, starters = m.Players, m.Starters
+		}
+	}
+	if players == nil {
+		roster, ok := l.Rosters[rosterID]
+		if !ok {
+			return rec, fmt.Errorf("unknown roster %d", rosterID)
+		}
+		players, starters = roster.Players, roster.Starters
+	}
+
+	playerStats, err := l.Client.GetPlayerStats(players, week, l.Season)
+	if err != nil {
+		return rec, err
+	}
+	projections := make(map[string]float64)
+	for _, ps := range playerStats.Data.Projected {
+		projections[ps.PlayerID] = l.scoreStats(ps.PlayerID, ps.Stats)
+	}
+
+	gamesByID, err := l.getGamesByID(week)
+	if err != nil {
+		return rec, err
+	}
+	gamesByTeam := make(map[string]gameMetadata)
+	for _, g := range gamesByID {
+		gamesByTeam[g.homeTeam] = g
+		gamesByTeam[g.awayTeam] = g
+	}
+
+	isStarter := make(map[string]bool)
+	for _, s := range starters {
+		isStarter[s] = true
+	}
+
+	// figure out who's available, and zero out anyone who won't be scoring
+	available := make([]string, 0, len(players))
+	locked := make(map[string]bool)
+	for _, p := range players {
+		game, playing := gamesByTeam[l.playerTeam(p)]
+		switch {
+		case !playing:
+			rec.Excluded = append(rec.Excluded, ExcludedPlayer{PlayerID: p, Reason: ExclusionReasonBye})
+			projections[p] = 0
+		case game.status != "pre_game":
+			// this comes before injuries, since even an injured starter can't be moved once their game kicks off
+			locked[p] = true
+			if !isStarter[p] {
+				rec.Excluded = append(rec.Excluded, ExcludedPlayer{PlayerID: p, Reason: ExclusionReasonLocked})
+			}
+		case inactiveInjuryStatuses[stringField(l.Client.NFLPlayers[p].InjuryStatus)]:
+			rec.Excluded = append(rec.Excluded, ExcludedPlayer{PlayerID: p, Reason: ExclusionReasonInjured})
+			projections[p] = 0
+		default:
+			available = append(available, p)
+		}
+	}
+
+	slots := l.startingSlots()
+	rec.Current = Lineup{Slots: make([]LineupSlot, len(slots))}
+	for i, slot := range slots {
+		p := emptySlot
+		if i < len(starters) && starters[i] != "" {
+			p = starters[i]
+		}
+		rec.Current.Slots[i] = LineupSlot{Position: slot, PlayerID: p, Points: projections[p]}
+		rec.Current.Points += projections[p]
+	}
+
+	// starters who are already playing can't be moved, so only optimize the remaining slots
+	openSlots := make([]string, 0, len(slots))
+	openIndexes := make([]int, 0, len(slots))
+	for i, s := range rec.Current.Slots {
+		if locked[s.PlayerID] {
+			continue
+		}
+		openSlots = append(openSlots, s.Position)
+		openIndexes = append(openIndexes, i)
+	}
+	best := solveLineup(openSlots, available, projections, l.playerPositions)
+
+	rec.Recommended = Lineup{Slots: make([]LineupSlot, len(slots))}
+	copy(rec.Recommended.Slots, rec.Current.Slots)
+	for i, idx := range openIndexes {
+		rec.Recommended.Slots[idx] = best.Slots[i]
+	}
+	l.alignLineup(rec.Recommended, rec.Current)
+	for i, s := range rec.Recommended.Slots {
+		rec.Recommended.Points += s.Points
+		current := rec.Current.Slots[i]
+		if s.PlayerID != current.PlayerID {
+			rec.Swaps = append(rec.Swaps, LineupSwap{
+				Slot: s.Position,
+				Out:  current.PlayerID,
+				In:   s.PlayerID,
+				Gain: s.Points - current.Points,
+			})
+		}
+	}
+
+	return rec, nil
+}
+
+// alignLineup shuffles players between slots in the recommended lineup so that anyone who's staying in the lineup
+// keeps their current slot where possible, which keeps the suggested swaps to a minimum.
+func (l League) alignLineup(recommended Lineup, current Lineup) {
+	slotOf := func(playerID string) int {
+		for i, s := range recommended.Slots {
+			if s.PlayerID == playerID {
+				return i
+			}
+		}
+		return -1
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for i, cur := range current.Slots {
+			if cur.PlayerID == emptySlot || recommended.Slots[i].PlayerID == cur.PlayerID {
+				continue
+			}
+			j := slotOf(cur.PlayerID)
+			if j < 0 {
+				continue
+			}
+			a, b := recommended.Slots[i], recommended.Slots[j]
+			if !canFill(a.Position, l.playerPositions(b.PlayerID)) {
+				continue
+			}
+			if a.PlayerID != emptySlot && !canFill(b.Position, l.playerPositions(a.PlayerID)) {
+				continue
+			}
+			recommended.Slots[i].PlayerID, recommended.Slots[i].Points = b.PlayerID, b.Points
+			recommended.Slots[j].PlayerID, recommended.Slots[j].Points = a.PlayerID, a.Points
+			changed = true
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package sleeper
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// how many entries to keep in each of the single game leaderboards
+const recordsListSize = 10
+
+// Records is the all-time record book for a league's history.
+type Records struct {
+	Owners []OwnerRecord `json:"owners"`
+	// HeadToHead is each owner's record against every other owner, keyed by owner ID and then opponent owner ID.
+	HeadToHead      map[string]map[string]HeadToHeadRecord `json:"head_to_head"`
+	HighestScores   []TeamScore                            `json:"highest_scores"`
+	LowestScores    []TeamScore                            `json:"lowest_scores"`
+	BiggestBlowouts []GameResult                           `json:"biggest_blowouts"`
+	ClosestGames    []GameResult                           `json:"closest_games"`
+	Champions       []Champion                             `json:"champions"`
+}
+
+// OwnerRecord is an owner's all-time regular season record.
+type OwnerRecord struct {
+	OwnerID            string  `json:"owner_id"`
+	DisplayName        string  `json:"display_name"`
+	Seasons            int     `json:"seasons"`
+	Wins               int     `json:"wins"`
+	Losses             int     `json:"losses"`
+	Ties               int     `json:"ties"`
+	PointsFor          float64 `json:"points_for"`
+	PointsAgainst      float64 `json:"points_against"`
+	PlayoffAppearances int     `json:"playoff_appearances"`
+	Championships      int     `json:"championships"`
+}
+
+// HeadToHeadRecord is one owner's regular season record against another.
+type HeadToHeadRecord struct {
+	Wins          int     `json:"wins"`
+	Losses        int     `json:"losses"`
+	Ties          int     `json:"ties"`
+	PointsFor     float64 `json:"points_for"`
+	PointsAgainst float64 `json:"points_against"`
+}
+
+// TeamScore is a single team's score in a single week.
+type TeamScore struct {
+	Season   string  `json:"season"`
+	Week     int     `json:"week"`
+	OwnerID  string  `json:"owner_id"`
+	TeamName string  `json:"team_name"`
+	Points   float64 `json:"points"`
+}
+
+// GameResult is the result of a single head-to-head matchup. For ties, the winner is just the first team.
+type GameResult struct {
+	Winner TeamScore `json:"winner"`
+	Loser  TeamScore `json:"loser"`
+	Margin float64   `json:"margin"`
+}
+
+// Champion is the winner of a single season.
+type Champion struct {
+	Season   string `json:"season"`
+	OwnerID  string `json:"owner_id"`
+	RosterID int    `json:"roster_id"`
+	TeamName string `json:"team_name"`
+}
+
+// JSON returns the records as indented JSON.
+func (r Records) JSON() ([]byte, error) {
+	return json.MarshalIndent(r, "", "  ")
+}
+
+// Records builds the all-time record book. Wins, losses and single game records only count the regular season,
+// while playoff appearances and champions come from each season's winners bracket.
+func (h LeagueHistory) Records() (Records, error) {
+	records := Records{
+		HeadToHead: make(map[string]map[string]HeadToHeadRecord),
+	}
+	owners := make(map[string]*OwnerRecord)
+	getOwner := func(ownerID string) *OwnerRecord {
+		o, ok := owners[ownerID]
+		if !ok {
+			o = &OwnerRecord{OwnerID: ownerID}
+			owners[ownerID] = o
+		}
+		return o
+	}
+	addHeadToHead := func(a TeamScore, b TeamScore) {
+		if _, ok := records.HeadToHead[a.OwnerID]; !ok {
+			records.HeadToHead[a.OwnerID] = make(map[string]HeadToHeadRecord)
+		}
+		h2h := records.HeadToHead[a.OwnerID][b.OwnerID]
+		h2h.PointsFor += a.Points
+		h2h.PointsAgainst += b.Points
+		switch compare(a.Points, b.Points) {
+		case 1:
+			h2h.Wins++
+		case -1:
+			h2h.Losses++
+		default:
+			h2h.Ties++
+		}
+		records.HeadToHead[a.OwnerID][b.OwnerID] = h2h
+	}
+
+	scores := make([]TeamScore, 0)
+	games := make([]GameResult, 0)
+	for _, l := range h {
+		for _, r := range l.Rosters {
+			if r.OwnerID == "" {
+				continue
+			}
+			o := getOwner(r.OwnerID)
+			o.Seasons++
+			// use the most recent display name
+			o.DisplayName = l.Users[r.OwnerID].DisplayName
+		}
+
+		teamScore := func(week int, m MatchupJSON) TeamScore {
+			return TeamScore{
+				Season:   l.Season,
+				Week:     week,
+				OwnerID:  l.Rosters[m.RosterID].OwnerID,
+				TeamName: l.TeamName(m.RosterID),
+				Points:   float64(m.Points),
+			}
+		}
+
+		for i := 0; i < l.playedWeeks(); i++ {
+			week := i + 1
+			for _, m := range l.Matchups[i] {
+				scores = append(scores, teamScore(week, m))
+			}
+			for _, pair := range pairMatchups(l.Matchups[i]) {
+				a, b := teamScore(week, pair[0]), teamScore(week, pair[1])
+				if a.Points < b.Points {
+					a, b = b, a
+				}
+				games = append(games, GameResult{Winner: a, Loser: b, Margin: a.Points - b.Points})
+				if a.OwnerID == "" || b.OwnerID == "" {
+					// orphaned teams don't have anyone to credit the result to
+					continue
+				}
+
+				addHeadToHead(a, b)
+				addHeadToHead(b, a)
+				winner, loser := getOwner(a.OwnerID), getOwner(b.OwnerID)
+				winner.PointsFor += a.Points
+				winner.PointsAgainst += b.Points
+				loser.PointsFor += b.Points
+				loser.PointsAgainst += a.Points
+				if a.Points == b.Points {
+					winner.Ties++
+					loser.Ties++
+				} else {
+					winner.Wins++
+					loser.Losses++
+				}
+			}
+		}
+
+		bracket, err := l.Client.GetLeagueWinnersBracket(l.ID)
+		if err != nil {
+			return records, err
+		}
+		playoffTeams := make(map[int]bool)
+		for _, bm := range bracket {
+			if bm.Team1 != 0 {
+				playoffTeams[bm.Team1] = true
+			}
+			if bm.Team2 != 0 {
+				playoffTeams[bm.Team2] = true
+			}
+		}
+		for rosterID := range playoffTeams {
+			if ownerID := l.Rosters[rosterID].OwnerID; ownerID != "" {
+				getOwner(ownerID).PlayoffAppearances++
+			}
+		}
+
+		if championID := seasonChampion(l, bracket); championID != 0 {
+			ownerID := l.Rosters[championID].OwnerID
+			records.Champions = append(records.Champions, Champion{
+				Season:   l.Season,
+				OwnerID:  ownerID,
+				RosterID: championID,
+				TeamName: l.TeamName(championID),
+			})
+			if ownerID != "" {
+				getOwner(ownerID).Championships++
+			}
+		}
+	}
+
+	for _, o := range owners {
+		records.Owners = append(records.Owners, *o)
+	}
+	sort.Slice(records.Owners, func(i, j int) bool {
+		a, b := records.Owners[i], records.Owners[j]
+		if a.Wins != b.Wins {
+			return a.Wins > b.Wins
+		}
+		if a.PointsFor != b.PointsFor {
+			return a.PointsFor > b.PointsFor
+		}
+		return a.OwnerID < b.OwnerID
+	})
+
+	sort.SliceStable(scores, func(i, j int) bool { return scores[i].Points > scores[j].Points })
+	records.HighestScores = firstScores(scores)
+	sort.SliceStable(scores, func(i, j int) bool { return scores[i].Points < scores[j].Points })
+	records.LowestScores = firstScores(scores)
+
+	sort.SliceStable(games, func(i, j int) bool { return games[i].Margin > games[j].Margin })
+	records.BiggestBlowouts = firstGames(games)
+	sort.SliceStable(games, func(i, j int) bool { return math.Abs(games[i].Margin) < math.Abs(games[j].Margin) })
+	records.ClosestGames = firstGames(games)
+
+	return records, nil
+}
+
+// seasonChampion returns the roster ID that won the given season, or 0 if it hasn't been decided. The bracket is
+// preferred because a renewed league's latest_league_winner_roster_id is last season's champion until this season's
+// is crowned.
+func seasonChampion(l League, bracket BracketJSON) int {
+	for _, bm := range bracket {
+		if bm.Place == 1 && bm.Winner != 0 {
+			return bm.Winner
+		}
+	}
+	if l.LeagueInfo.Status != "complete" {
+		return 0
+	}
+	if winner, err := strconv.Atoi(l.LeagueInfo.Metadata.LatestLeagueWinnerRosterID); err == nil {
+		return winner
+	}
+	return 0
+}
+
+func firstScores(scores []TeamScore) []TeamScore {
+	if len(scores) > recordsListSize {
+		scores = scores[:recordsListSize]
+	}
+	return append([]TeamScore(nil), scores...)
+}
+
+func firstGames(games []GameResult) []GameResult {
+	if len(games) > recordsListSize {
+		games = games[:recordsListSize]
+	}
+	return append([]GameResult(nil), games...)
+}
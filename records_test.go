@@ -0,0 +1,104 @@
+package sleeper
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// historyLeague builds a season with two rosters, owned by "alice" and "bob".
+func historyLeague(c Client, leagueID string, season string, status string, latestWinner string) League {
+	l := League{
+		Client: c,
+		ID:     leagueID,
+		Season: season,
+		Rosters: map[int]RosterJSON{
+			1: {RosterID: 1, OwnerID: "alice"},
+			2: {RosterID: 2, OwnerID: "bob"},
+		},
+		Users: map[string]UserJSON{
+			"alice": {UserID: "alice", DisplayName: "alice"},
+			"bob":   {UserID: "bob", DisplayName: "bob"},
+		},
+	}
+	l.LeagueInfo.Status = status
+	l.LeagueInfo.Metadata.LatestLeagueWinnerRosterID = latestWinner
+	return l
+}
+
+func TestRecordsChampionsAcrossRenewal(t *testing.T) {
+	brackets := map[string]BracketJSON{
+		// last season's final, won by roster 2
+		"/league/2022/winners_bracket": {{Round: 1, Matchup: 1, Team1: 1, Team2: 2, Winner: 2, Loser: 1, Place: 1}},
+		// this season's final hasn't been played yet
+		"/league/2023/winners_bracket": {{Round: 1, Matchup: 1, Team1: 1, Team2: 2, Place: 1}},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bracket, ok := brackets[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(bracket)
+	}))
+	defer srv.Close()
+	c := Client{httpClient: srv.Client(), sleeperURL: srv.URL}
+
+	history := LeagueHistory{
+		historyLeague(c, "2022", "2022", "complete", "2"),
+		// the renewed league still carries last season's winner
+		historyLeague(c, "2023", "2023", "in_season", "2"),
+	}
+	records, err := history.Records()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(records.Champions) != 1 {
+		t.Fatalf("got %d champions, want 1: %+v", len(records.Champions), records.Champions)
+	}
+	if champ := records.Champions[0]; champ.Season != "2022" || champ.RosterID != 2 || champ.OwnerID != "bob" {
+		t.Errorf("got champion %+v, want bob's roster 2 in 2022", champ)
+	}
+	for _, o := range records.Owners {
+		want := 0
+		if o.OwnerID == "bob" {
+			want = 1
+		}
+		if o.Championships != want {
+			t.Errorf("%s has %d championships, want %d", o.OwnerID, o.Championships, want)
+		}
+		if o.Seasons != 2 || o.PlayoffAppearances != 2 {
+			t.Errorf("%s has %d seasons and %d playoff appearances, want 2 and 2", o.OwnerID, o.Seasons, o.PlayoffAppearances)
+		}
+	}
+}
+
+func TestSeasonChampion(t *testing.T) {
+	final := BracketJSON{{Round: 3, Matchup: 7, Team1: 4, Team2: 9, Winner: 9, Loser: 4, Place: 1}}
+	unplayed := BracketJSON{{Round: 3, Matchup: 7, Team1: 4, Team2: 9, Place: 1}}
+
+	tests := []struct {
+		name         string
+		status       string
+		latestWinner string
+		bracket      BracketJSON
+		want         int
+	}{
+		{"bracket winner", "complete", "9", final, 9},
+		{"bracket beats metadata", "complete", "4", final, 9},
+		{"undecided renewed league", "in_season", "4", unplayed, 0},
+		{"undecided in playoffs", "post_season", "4", unplayed, 0},
+		{"complete without bracket", "complete", "4", nil, 4},
+		{"complete without any winner", "complete", "", nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := historyLeague(Client{}, "1", "2023", tt.status, tt.latestWinner)
+			if got := seasonChampion(l, tt.bracket); got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package sleeper
+
+// RescoredMatchup is a single head-to-head matchup scored both ways.
+type RescoredMatchup struct {
+	Week            int
+	MatchupID       int
+	RosterIDs       [2]int
+	ActualPoints    [2]float64
+	AlternatePoints [2]float64
+	// Flipped is true when the alternate scoring changes the result of the matchup.
+	Flipped bool
+}
+
+// StandingChange compares a team's actual standing to its standing under alternate scoring.
+type StandingChange struct {
+	RosterID      int
+	ActualRank    int
+	AlternateRank int
+	// WinsChange is alternate wins minus actual wins.
+	WinsChange int
+	// PointsForChange is alternate points for minus actual points for.
+	PointsForChange float64
+}
+
+// RescoreResult is the outcome of replaying a season under different scoring settings.
+type RescoreResult struct {
+	Matchups           []RescoredMatchup
+	ActualStandings    []Standing
+	AlternateStandings []Standing
+	Changes            []StandingChange
+	FlippedMatchups    int
+}
+
+// Rescore replays every played week's starters under the given scoring settings, and compares the resulting
+// matchups and standings with what actually happened.
+func (l League) Rescore(settings map[string]float64) (RescoreResult, error) {
+	result := RescoreResult{}
+	scorer := NewScorer(settings)
+	weeks := l.Matchups[:l.playedWeeks()]
+
+	// alternate points keyed by week and then roster ID
+	alternate := make(map[int]map[int]float64)
+	for i, weekMatchups := range weeks {
+		week := i + 1
+		starters := make([]string, 0)
+		for _, m := range weekMatchups {
+			starters = append(starters, m.Starters...)
+		}
+		playerStats, err := l.Client.GetPlayerStats(starters, week, l.Season)
+		if err != nil {
+			return result, err
+		}
+		statsByPlayer := make(map[string]StatsJSON)
+		for _, as := range playerStats.Data.Actual {
+			statsByPlayer[as.PlayerID] = as
+		}
+
+		alternate[week] = make(map[int]float64)
+		for _, m := range weekMatchups {
+			points := 0.0
+			for _, p := range m.Starters {
+				if as, ok := statsByPlayer[p]; ok {
+					points += scorer.Score(as.Stats, l.Client.NFLPlayers[p].Position)
+				}
+			}
+			alternate[week][m.RosterID] = points
+		}
+
+		for _, pair := range pairMatchups(weekMatchups) {
+			rm := RescoredMatchup{
+				Week:      week,
+				MatchupID: pair[0].MatchupID,
+			}
+			for j, m := range pair {
+				rm.RosterIDs[j] = m.RosterID
+				rm.ActualPoints[j] = float64(m.Points)
+				rm.AlternatePoints[j] = alternate[week][m.RosterID]
+			}
+			rm.Flipped = compare(rm.ActualPoints[0], rm.ActualPoints[1]) != compare(rm.AlternatePoints[0], rm.AlternatePoints[1])
+			if rm.Flipped {
+				result.FlippedMatchups++
+			}
+			result.Matchups = append(result.Matchups, rm)
+		}
+	}
+
+	result.ActualStandings = computeStandings(weeks, matchupPoints)
+	result.AlternateStandings = computeStandings(weeks, func(week int, m MatchupJSON) float64 {
+		return alternate[week][m.RosterID]
+	})
+
+	actualByRoster := make(map[int]Standing)
+	for _, s := range result.ActualStandings {
+		actualByRoster[s.RosterID] = s
+	}
+	for _, alt := range result.AlternateStandings {
+		actual := actualByRoster[alt.RosterID]
+		result.Changes = append(result.Changes, StandingChange{
+			RosterID:        alt.RosterID,
+			ActualRank:      actual.Rank,
+			AlternateRank:   alt.Rank,
+			WinsChange:      alt.Wins - actual.Wins,
+			PointsForChange: alt.PointsFor - actual.PointsFor,
+		})
+	}
+
+	return result, nil
+}
+
+// compare returns 1 if a > b, -1 if a < b and 0 if they're equal.
+func compare(a float64, b float64) int {
+	switch {
+	case a > b:
+		return 1
+	case a < b:
+		return -1
+	}
+	return 0
+}
@@ -0,0 +1,132 @@
+package sleeper
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ScheduledGame is one NFL team's game in a given week.
+type ScheduledGame struct {
+	Week      int
+	GameID    string
+	Opponent  string
+	Home      bool
+	StartTime int64
+}
+
+// NFLSchedule maps each NFL team to its games, keyed by week. Weeks without a game are byes.
+type NFLSchedule map[string]map[int]ScheduledGame
+
+// Game returns the given team's game in the given week, or false if they're on bye.
+func (s NFLSchedule) Game(team string, week int) (ScheduledGame, bool) {
+	g, ok := s[team][week]
+	return g, ok
+}
+
+// IsBye reports whether the given team has no game in the given week.
+func (s NFLSchedule) IsBye(team string, week int) bool {
+	_, ok := s.Game(team, week)
+	return !ok
+}
+
+// ByeWeeks returns the regular season weeks the given team doesn't play.
+func (s NFLSchedule) ByeWeeks(team string) []int {
+	byes := make([]int, 0)
+	for week := 1; week <= nflRegularSeasonWeeks; week++ {
+		if s.IsBye(team, week) {
+			byes = append(byes, week)
+		}
+	}
+	return byes
+}
+
+// GetNFLSchedule builds the regular season schedule for every NFL team by fetching each week's batch scores.
+func (c Client) GetNFLSchedule(season string) (NFLSchedule, error) {
+	schedule := make(NFLSchedule)
+	addGame := func(team string, g ScheduledGame) {
+		if _, ok := schedule[team]; !ok {
+			schedule[team] = make(map[int]ScheduledGame)
+		}
+		schedule[team][g.Week] = g
+	}
+
+	for week := 1; week <= nflRegularSeasonWeeks; week++ {
+		scores, err := c.GetBatchScores(week, season)
+		if err != nil {
+			return nil, err
+		}
+		for _, game := range scores.Data.Scores {
+			home, away := game.Metadata.HomeTeam, game.Metadata.AwayTeam
+			if home == "" || away == "" {
+				continue
+			}
+			addGame(home, ScheduledGame{Week: week, GameID: game.GameID, Opponent: away, Home: true, StartTime: game.StartTime})
+			addGame(away, ScheduledGame{Week: week, GameID: game.GameID, Opponent: home, Home: false, StartTime: game.StartTime})
+		}
+	}
+	return schedule, nil
+}
+
+// ByeWeekConflict is a week where byes leave a roster unable to fill every starting slot.
+type ByeWeekConflict struct {
+	Week         int
+	EmptySlots   []string
+	PlayersOnBye []string
+}
+
+// ByeWeekConflicts reports the rest of the regular season's weeks where the given roster can't field a full
+// starting lineup because of byes.
+func (l League) ByeWeekConflicts(rosterID int) ([]ByeWeekConflict, error) {
+	roster, ok := l.Rosters[rosterID]
+	if !ok {
+		return nil, fmt.Errorf("unknown roster %d", rosterID)
+	}
+	schedule, err := l.Client.GetNFLSchedule(l.Season)
+	if err != nil {
+		return nil, err
+	}
+	weeks, err := l.remainingWeeks()
+	if err != nil {
+		return nil, err
+	}
+
+	// every player counts the same, since all we care about is filling slots
+	points := make(map[string]float64)
+	for _, p := range roster.Players {
+		points[p] = 1
+	}
+
+	conflicts := make([]ByeWeekConflict, 0)
+	for _, week := range weeks {
+		available := make([]string, 0, len(roster.Players))
+		onBye := make([]string, 0)
+		for _, p := range roster.Players {
+			team := l.playerTeam(p)
+			if team == "" {
+				// free agents aren't going to play either
+				continue
+			}
+			if schedule.IsBye(team, week) {
+				onBye = append(onBye, p)
+				continue
+			}
+			available = append(available, p)
+		}
+
+		emptySlots := make([]string, 0)
+		for _, s := range l.bestLineup(available, points).Slots {
+			if s.PlayerID == emptySlot {
+				emptySlots = append(emptySlots, s.Position)
+			}
+		}
+		if len(emptySlots) > 0 {
+			sort.Strings(onBye)
+			conflicts = append(conflicts, ByeWeekConflict{
+				Week:         week,
+				EmptySlots:   emptySlots,
+				PlayersOnBye: onBye,
+			})
+		}
+	}
+	return conflicts, nil
+}
@@ -0,0 +1,250 @@
+package sleeper
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// statThreshold is a bonus that's earned by reaching a threshold in the sum of one or more raw stats.
+type statThreshold struct {
+	stats []string
+	min   float64
+	// max is exclusive, with zero meaning there's no upper limit
+	max float64
+}
+
+func (t statThreshold) earned(stats map[string]float64) bool {
+	total := 0.0
+	found := false
+	for _, s := range t.stats {
+		if v, ok := stats[s]; ok {
+			total += v
+			found = true
+		}
+	}
+	if !found {
+		return false
+	}
+	return total >= t.min && (t.max == 0 || total < t.max)
+}
+
+// derivedStats are scoring settings keys that Sleeper computes from raw stats rather than counting directly.
+// Sleeper usually includes these in stat lines already, so they're only derived when missing.
+var derivedStats = map[string]statThreshold{
+	// yardage and volume bonuses
+	"bonus_pass_yd_300":     {stats: []string{"pass_yd"}, min: 300},
+	"bonus_pass_yd_400":     {stats: []string{"pass_yd"}, min: 400},
+	"bonus_pass_cmp_25":     {stats: []string{"pass_cmp"}, min: 25},
+	"bonus_rush_yd_100":     {stats: []string{"rush_yd"}, min: 100},
+	"bonus_rush_yd_200":     {stats: []string{"rush_yd"}, min: 200},
+	"bonus_rush_att_20":     {stats: []string{"rush_att"}, min: 20},
+	"bonus_rec_yd_100":      {stats: []string{"rec_yd"}, min: 100},
+	"bonus_rec_yd_200":      {stats: []string{"rec_yd"}, min: 200},
+	"bonus_rush_rec_yd_100": {stats: []string{"rush_yd", "rec_yd"}, min: 100},
+	"bonus_rush_rec_yd_200": {stats: []string{"rush_yd", "rec_yd"}, min: 200},
+	"bonus_tkl_10p":         {stats: []string{"idp_tkl"}, min: 10},
+	"bonus_sack_2p":         {stats: []string{"idp_sack"}, min: 2},
+
+	// team defense points allowed brackets
+	"pts_allow_0":     {stats: []string{"pts_allow"}, min: 0, max: 1},
+	"pts_allow_1_6":   {stats: []string{"pts_allow"}, min: 1, max: 7},
+	"pts_allow_7_13":  {stats: []string{"pts_allow"}, min: 7, max: 14},
+	"pts_allow_14_20": {stats: []string{"pts_allow"}, min: 14, max: 21},
+	"pts_allow_21_27": {stats: []string{"pts_allow"}, min: 21, max: 28},
+	"pts_allow_28_34": {stats: []string{"pts_allow"}, min: 28, max: 35},
+	"pts_allow_35p":   {stats: []string{"pts_allow"}, min: 35},
+
+	// team defense yards allowed brackets
+	"yds_allow_0_100":   {stats: []string{"yds_allow"}, min: 0, max: 100},
+	"yds_allow_100_199": {stats: []string{"yds_allow"}, min: 100, max: 200},
+	"yds_allow_200_299": {stats: []string{"yds_allow"}, min: 200, max: 300},
+	"yds_allow_300_349": {stats: []string{"yds_allow"}, min: 300, max: 350},
+	"yds_allow_350_399": {stats: []string{"yds_allow"}, min: 350, max: 400},
+	"yds_allow_400_449": {stats: []string{"yds_allow"}, min: 400, max: 450},
+	"yds_allow_450_499": {stats: []string{"yds_allow"}, min: 450, max: 500},
+	"yds_allow_500_549": {stats: []string{"yds_allow"}, min: 500, max: 550},
+	"yds_allow_550p":    {stats: []string{"yds_allow"}, min: 550},
+}
+
+// positionBonuses are per-reception bonuses that only apply to one position, like TE premium.
+var positionBonuses = map[string]string{
+	"bonus_rec_te": "TE",
+	"bonus_rec_rb": "RB",
+	"bonus_rec_wr": "WR",
+}
+
+// Scorer scores stat lines using a league's scoring settings.
+type Scorer struct {
+	settings map[string]float64
+}
+
+// NewScorer creates a Scorer from a league's scoring settings.
+func NewScorer(settings map[string]float64) Scorer {
+	return Scorer{settings: settings}
+}
+
+// Score returns the fantasy points for a stat line. The position is needed for position-specific bonuses like TE
+// premium, and can be left empty if the league doesn't use any.
+func (s Scorer) Score(stats map[string]float64, position string) float64 {
+	total := 0.0
+	for _, line := range s.Explain(stats, position) {
+		total += line.Points
+	}
+	return total
+}
+
+// Explain breaks a stat line's score down into one line per scored stat, biggest contributors first.
+func (s Scorer) Explain(stats map[string]float64, position string) []ScoreLine {
+	scored := s.scoredStats(stats, position)
+	lines := make([]ScoreLine, 0, len(scored))
+	for key, value := range scored {
+		multiplier := s.settings[key]
+		if multiplier == 0 {
+			continue
+		}
+		lines = append(lines, ScoreLine{
+			Stat:       key,
+			Value:      value,
+			Multiplier: multiplier,
+			Points:     multiplier * value,
+		})
+	}
+	sort.Slice(lines, func(i, j int) bool {
+		pi, pj := math.Abs(lines[i].Points), math.Abs(lines[j].Points)
+		if pi != pj {
+			return pi > pj
+		}
+		return lines[i].Stat < lines[j].Stat
+	})
+	return lines
+}
+
+// ScoreLine is the points earned from a single stat.
+type ScoreLine struct {
+	Stat       string
+	Value      float64
+	Multiplier float64
+	Points     float64
+}
+
+// String formats the line for humans, e.g. "112 rec yds (11.2)" or "100+ rec yds bonus (3.0)".
+func (sl ScoreLine) String() string {
+	label, ok := statLabels[sl.Stat]
+	if !ok {
+		label = sl.Stat
+	}
+	if _, isBonus := derivedStats[sl.Stat]; isBonus && sl.Value == 1 {
+		return fmt.Sprintf("%s (%.1f)", label, sl.Points)
+	}
+	return fmt.Sprintf("%s %s (%.1f)", strconv.FormatFloat(sl.Value, 'f', -1, 64), label, sl.Points)
+}
+
+// human readable names for the most common stats
+var statLabels = map[string]string{
+	"pass_yd":               "pass yds",
+	"pass_td":               "pass TD",
+	"pass_int":              "INT",
+	"pass_2pt":              "pass 2pt",
+	"pass_cmp":              "cmp",
+	"pass_fd":               "pass 1st downs",
+	"rush_yd":               "rush yds",
+	"rush_td":               "rush TD",
+	"rush_2pt":              "rush 2pt",
+	"rush_att":              "rush att",
+	"rush_fd":               "rush 1st downs",
+	"rec":                   "rec",
+	"rec_yd":                "rec yds",
+	"rec_td":                "rec TD",
+	"rec_2pt":               "rec 2pt",
+	"rec_fd":                "rec 1st downs",
+	"fum_lost":              "fum lost",
+	"fgm":                   "FG",
+	"xpm":                   "XP",
+	"xpmiss":                "XP missed",
+	"fgmiss":                "FG missed",
+	"sack":                  "sacks",
+	"int":                   "INT",
+	"fum_rec":               "fum rec",
+	"def_td":                "def TD",
+	"safe":                  "safety",
+	"bonus_rec_te":          "TE premium",
+	"bonus_rec_rb":          "RB rec bonus",
+	"bonus_rec_wr":          "WR rec bonus",
+	"bonus_pass_yd_300":     "300+ pass yds bonus",
+	"bonus_pass_yd_400":     "400+ pass yds bonus",
+	"bonus_rush_yd_100":     "100+ rush yds bonus",
+	"bonus_rush_yd_200":     "200+ rush yds bonus",
+	"bonus_rec_yd_100":      "100+ rec yds bonus",
+	"bonus_rec_yd_200":      "200+ rec yds bonus",
+	"bonus_rush_rec_yd_100": "100+ rush/rec yds bonus",
+	"bonus_rush_rec_yd_200": "200+ rush/rec yds bonus",
+	"pts_allow_0":           "shutout",
+	"pts_allow_1_6":         "1-6 pts allowed",
+	"pts_allow_7_13":        "7-13 pts allowed",
+	"pts_allow_14_20":       "14-20 pts allowed",
+	"pts_allow_21_27":       "21-27 pts allowed",
+	"pts_allow_28_34":       "28-34 pts allowed",
+	"pts_allow_35p":         "35+ pts allowed",
+}
+
+// ScoreBreakdown is where a player's points came from in a given week.
+type ScoreBreakdown struct {
+	PlayerID string
+	Week     int
+	Lines    []ScoreLine
+	Total    float64
+}
+
+// String joins the breakdown into a single line, e.g. "8 rec (8.0) + 112 rec yds (11.2) + 100+ rec yds bonus (3.0)".
+func (b ScoreBreakdown) String() string {
+	parts := make([]string, len(b.Lines))
+	for i, line := range b.Lines {
+		parts[i] = line.String()
+	}
+	return strings.Join(parts, " + ")
+}
+
+// PlayerScoreBreakdown explains how the given player scored their points in the given week.
+func (l League) PlayerScoreBreakdown(playerID string, week int) (ScoreBreakdown, error) {
+	breakdown := ScoreBreakdown{PlayerID: playerID, Week: week}
+	playerStats, err := l.Client.GetPlayerStats([]string{playerID}, week, l.Season)
+	if err != nil {
+		return breakdown, err
+	}
+	for _, as := range playerStats.Data.Actual {
+		if as.PlayerID != playerID {
+			continue
+		}
+		breakdown.Lines = NewScorer(l.LeagueInfo.ScoringSettings).Explain(as.Stats, l.Client.NFLPlayers[playerID].Position)
+		for _, line := range breakdown.Lines {
+			breakdown.Total += line.Points
+		}
+	}
+	return breakdown, nil
+}
+
+// scoredStats returns every stat in the line that the league awards points for, including bonuses and brackets
+// derived from the raw stats.
+func (s Scorer) scoredStats(stats map[string]float64, position string) map[string]float64 {
+	scored := make(map[string]float64)
+	for key, value := range stats {
+		if _, ok := s.settings[key]; ok {
+			scored[key] = value
+		}
+	}
+	for key := range s.settings {
+		if _, ok := stats[key]; ok {
+			continue
+		}
+		if threshold, ok := derivedStats[key]; ok && threshold.earned(stats) {
+			scored[key] = 1
+		}
+		if bonusPosition, ok := positionBonuses[key]; ok && bonusPosition == position && stats["rec"] != 0 {
+			scored[key] = stats["rec"]
+		}
+	}
+	return scored
+}
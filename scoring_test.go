@@ -0,0 +1,82 @@
+package sleeper
+
+import (
+	"math"
+	"testing"
+)
+
+// scoringFixture is a week of stat lines and matchups in Sleeper's response format. The one in testdata is synthetic:
+// its starters_points were worked out by hand from the same rules Scorer implements, so it guards against
+// regressions rather than proving the rules match Sleeper's.
+type scoringFixture struct {
+	ScoringSettings map[string]float64 `json:"scoring_settings"`
+	Positions       map[string]string  `json:"positions"`
+	Stats           []StatsJSON        `json:"stats"`
+	Matchups        MatchupsJSON       `json:"matchups"`
+}
+
+func TestScorerSyntheticWeek(t *testing.T) {
+	fixture := scoringFixture{}
+	loadFixture(t, "scoring_week.json", &fixture)
+	scorer := NewScorer(fixture.ScoringSettings)
+
+	statsByPlayer := make(map[string]map[string]float64)
+	for _, s := range fixture.Stats {
+		statsByPlayer[s.PlayerID] = s.Stats
+	}
+
+	for _, m := range fixture.Matchups {
+		total := 0.0
+		for i, p := range m.Starters {
+			got := scorer.Score(statsByPlayer[p], fixture.Positions[p])
+			if math.Abs(got-m.StartersPoints[i]) > 0.005 {
+				t.Errorf("roster %d starter %s scored %.2f, fixture has %.2f", m.RosterID, p, got, m.StartersPoints[i])
+			}
+			total += got
+		}
+		if math.Abs(total-float64(m.Points)) > 0.01 {
+			t.Errorf("roster %d scored %.2f, fixture has %.2f", m.RosterID, total, m.Points)
+		}
+	}
+}
+
+func TestScorerDerivedStats(t *testing.T) {
+	fixture := scoringFixture{}
+	loadFixture(t, "scoring_week.json", &fixture)
+	scorer := NewScorer(fixture.ScoringSettings)
+
+	tests := []struct {
+		name     string
+		stats    map[string]float64
+		position string
+		want     float64
+	}{
+		{"shutout", map[string]float64{"pts_allow": 0}, "DEF", 10},
+		{"one point allowed", map[string]float64{"pts_allow": 1}, "DEF", 7},
+		{"13 points allowed", map[string]float64{"pts_allow": 13}, "DEF", 4},
+		{"14 points allowed", map[string]float64{"pts_allow": 14}, "DEF", 1},
+		{"35 points allowed", map[string]float64{"pts_allow": 35}, "DEF", -4},
+		{"99 yards allowed", map[string]float64{"yds_allow": 99}, "DEF", 5},
+		{"100 yards allowed", map[string]float64{"yds_allow": 100}, "DEF", 3},
+		{"550 yards allowed", map[string]float64{"yds_allow": 550}, "DEF", -7},
+		{"no points allowed stat", map[string]float64{"sack": 1}, "DEF", 1},
+
+		{"derived 100 yard bonus", map[string]float64{"rec_yd": 100}, "WR", 13},
+		{"99 yards is no bonus", map[string]float64{"rec_yd": 99}, "WR", 9.9},
+		{"bonus already in the stat line", map[string]float64{"rec_yd": 100, "bonus_rec_yd_100": 1}, "WR", 13},
+		// Sleeper decided the bonus wasn't earned, so it isn't derived
+		{"bonus zeroed in the stat line", map[string]float64{"rec_yd": 100, "bonus_rec_yd_100": 0}, "WR", 10},
+
+		{"TE premium", map[string]float64{"rec": 4}, "TE", 4},
+		{"no TE premium for WRs", map[string]float64{"rec": 4}, "WR", 2},
+		{"no TE premium without catches", map[string]float64{"rec": 0}, "TE", 0},
+		{"TE premium already in the stat line", map[string]float64{"rec": 4, "bonus_rec_te": 4}, "TE", 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := scorer.Score(tt.stats, tt.position); !approx(got, tt.want) {
+				t.Errorf("Score() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
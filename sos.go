@@ -0,0 +1,159 @@
+package sleeper
+
+import "sort"
+
+// TeamScheduleStrength is how tough a team's fantasy schedule has been and will be.
+type TeamScheduleStrength struct {
+	RosterID int
+
+	PastOpponents []int
+	// averages of each past opponent's season-long winning percentage and points per game
+	PastOpponentWinPercentage float64
+	PastOpponentPointsPerGame float64
+	// PastRank is 1 for the team that has had the hardest schedule so far.
+	PastRank int
+
+	RemainingOpponents             []int
+	RemainingOpponentWinPercentage float64
+	RemainingOpponentPointsPerGame float64
+	// RemainingRank is 1 for the team with the hardest schedule left.
+	RemainingRank int
+}
+
+// ScheduleStrength is the strength of schedule report for a league.
+type ScheduleStrength struct {
+	Teams []TeamScheduleStrength
+	// DefenseFantasyPointsAllowed is the average fantasy points per game each NFL defense has given up to each
+	// position, keyed by team and then position, using this league's scoring.
+	DefenseFantasyPointsAllowed map[string]map[string]float64
+}
+
+// StrengthOfSchedule reports each team's past and remaining strength of schedule, judged by their opponents'
+// records and scoring so far. It also works out how many fantasy points each NFL defense allows by position, based
+// on the players rostered in the league.
+func (l League) StrengthOfSchedule() (ScheduleStrength, error) {
+	report := ScheduleStrength{}
+	played := l.playedWeeks()
+
+	standingsByRoster := make(map[int]Standing)
+	for _, s := range computeStandings(l.Matchups[:played], matchupPoints) {
+		standingsByRoster[s.RosterID] = s
+	}
+	pointsPerGame := func(rosterID int) float64 {
+		s := standingsByRoster[rosterID]
+		games := s.Wins + s.Losses + s.Ties
+		if games == 0 {
+			return 0
+		}
+		return s.PointsFor / float64(games)
+	}
+
+	byRoster := make(map[int]*TeamScheduleStrength)
+	for i, weekMatchups := range l.Matchups {
+		for _, pair := range pairMatchups(weekMatchups) {
+			for j, m := range pair {
+				opponent := pair[1-j].RosterID
+				ts, ok := byRoster[m.RosterID]
+				if !ok {
+					ts = &TeamScheduleStrength{RosterID: m.RosterID}
+					byRoster[m.RosterID] = ts
+				}
+				if i < played {
+					ts.PastOpponents = append(ts.PastOpponents, opponent)
+				} else {
+					ts.RemainingOpponents = append(ts.RemainingOpponents, opponent)
+				}
+			}
+		}
+	}
+
+	for _, ts := range byRoster {
+		for _, o := range ts.PastOpponents {
+			ts.PastOpponentWinPercentage += standingsByRoster[o].WinPercentage()
+			ts.PastOpponentPointsPerGame += pointsPerGame(o)
+		}
+		if n := float64(len(ts.PastOpponents)); n > 0 {
+			ts.PastOpponentWinPercentage /= n
+			ts.PastOpponentPointsPerGame /= n
+		}
+		for _, o := range ts.RemainingOpponents {
+			ts.RemainingOpponentWinPercentage += standingsByRoster[o].WinPercentage()
+			ts.RemainingOpponentPointsPerGame += pointsPerGame(o)
+		}
+		if n := float64(len(ts.RemainingOpponents)); n > 0 {
+			ts.RemainingOpponentWinPercentage /= n
+			ts.RemainingOpponentPointsPerGame /= n
+		}
+		report.Teams = append(report.Teams, *ts)
+	}
+
+	// rank by opponents' points per game, which is less noisy than win percentage early on
+	sort.Slice(report.Teams, func(i, j int) bool {
+		a, b := report.Teams[i], report.Teams[j]
+		if a.RemainingOpponentPointsPerGame != b.RemainingOpponentPointsPerGame {
+			return a.RemainingOpponentPointsPerGame > b.RemainingOpponentPointsPerGame
+		}
+		return a.RosterID < b.RosterID
+	})
+	for i := range report.Teams {
+		report.Teams[i].RemainingRank = i + 1
+	}
+	sort.Slice(report.Teams, func(i, j int) bool {
+		a, b := report.Teams[i], report.Teams[j]
+		if a.PastOpponentPointsPerGame != b.PastOpponentPointsPerGame {
+			return a.PastOpponentPointsPerGame > b.PastOpponentPointsPerGame
+		}
+		return a.RosterID < b.RosterID
+	})
+	for i := range report.Teams {
+		report.Teams[i].PastRank = i + 1
+	}
+
+	allowed, err := l.defenseFantasyPointsAllowed(played)
+	if err != nil {
+		return report, err
+	}
+	report.DefenseFantasyPointsAllowed = allowed
+
+	return report, nil
+}
+
+// defenseFantasyPointsAllowed averages the fantasy points scored against each NFL team by position over the first
+// few weeks, using every player rostered in the league that week.
+func (l League) defenseFantasyPointsAllowed(weeks int) (map[string]map[string]float64, error) {
+	totals := make(map[string]map[string]float64)
+	games := make(map[string]int)
+	for i := 0; i < weeks; i++ {
+		players := make([]string, 0)
+		for _, m := range l.Matchups[i] {
+			players = append(players, m.Players...)
+		}
+		playerStats, err := l.Client.GetPlayerStats(players, i+1, l.Season)
+		if err != nil {
+			return nil, err
+		}
+
+		defensesPlayed := make(map[string]bool)
+		for _, as := range playerStats.Data.Actual {
+			if as.Opponent == "" {
+				continue
+			}
+			position := l.Client.NFLPlayers[as.PlayerID].Position
+			if _, ok := totals[as.Opponent]; !ok {
+				totals[as.Opponent] = make(map[string]float64)
+			}
+			totals[as.Opponent][position] += l.scoreStats(as.PlayerID, as.Stats)
+			defensesPlayed[as.Opponent] = true
+		}
+		for d := range defensesPlayed {
+			games[d]++
+		}
+	}
+
+	for d, byPosition := range totals {
+		for pos := range byPosition {
+			byPosition[pos] /= float64(games[d])
+		}
+	}
+	return totals, nil
+}
@@ -0,0 +1,92 @@
+package sleeper
+
+import "sort"
+
+// Standing is a team's regular season record.
+type Standing struct {
+	Rank          int
+	RosterID      int
+	Wins          int
+	Losses        int
+	Ties          int
+	PointsFor     float64
+	PointsAgainst float64
+}
+
+// WinPercentage returns the team's winning percentage, counting ties as half a win.
+func (s Standing) WinPercentage() float64 {
+	games := s.Wins + s.Losses + s.Ties
+	if games == 0 {
+		return 0
+	}
+	return (float64(s.Wins) + float64(s.Ties)/2) / float64(games)
+}
+
+// Standings returns the regular season standings for every week played so far.
+func (l League) Standings() []Standing {
+	return computeStandings(l.Matchups[:l.playedWeeks()], matchupPoints)
+}
+
+// computeStandings builds standings from head-to-head results for the given weeks, using score to decide how many
+// points each team put up. Teams are ranked by winning percentage, then points for.
+func computeStandings(weeks []MatchupsJSON, score func(week int, m MatchupJSON) float64) []Standing {
+	byRoster := make(map[int]*Standing)
+	getStanding := func(rosterID int) *Standing {
+		s, ok := byRoster[rosterID]
+		if !ok {
+			s = &Standing{RosterID: rosterID}
+			byRoster[rosterID] = s
+		}
+		return s
+	}
+
+	for i, weekMatchups := range weeks {
+		week := i + 1
+		for _, m := range weekMatchups {
+			getStanding(m.RosterID)
+		}
+		for _, pair := range pairMatchups(weekMatchups) {
+			a, b := getStanding(pair[0].RosterID), getStanding(pair[1].RosterID)
+			pointsA, pointsB := score(week, pair[0]), score(week, pair[1])
+			a.PointsFor += pointsA
+			a.PointsAgainst += pointsB
+			b.PointsFor += pointsB
+			b.PointsAgainst += pointsA
+			switch {
+			case pointsA > pointsB:
+				a.Wins++
+				b.Losses++
+			case pointsA < pointsB:
+				a.Losses++
+				b.Wins++
+			default:
+				a.Ties++
+				b.Ties++
+			}
+		}
+	}
+
+	standings := make([]Standing, 0, len(byRoster))
+	for _, s := range byRoster {
+		standings = append(standings, *s)
+	}
+	sort.Slice(standings, func(i, j int) bool {
+		pi, pj := standings[i].WinPercentage(), standings[j].WinPercentage()
+		if pi != pj {
+			return pi > pj
+		}
+		if standings[i].PointsFor != standings[j].PointsFor {
+			return standings[i].PointsFor > standings[j].PointsFor
+		}
+		return standings[i].RosterID < standings[j].RosterID
+	})
+	for i := range standings {
+		standings[i].Rank = i + 1
+	}
+	return standings
+}
+
+// matchupPoints scores a matchup with the points Sleeper recorded for it.
+func matchupPoints(_ int, m MatchupJSON) float64 {
+	return float64(m.Points)
+}
@@ -0,0 +1,195 @@
+package sleeper
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// number of bench players that count towards bench depth
+const benchDepthPlayers = 3
+
+// a trade is flagged as lopsided when the two sides' weekly starter point changes differ by at least this much
+const lopsidedTradeMargin = 5.0
+
+// TradeSide is how a trade changes one roster over the rest of the season. Points are weekly averages.
+type TradeSide struct {
+	RosterID int
+	Sends    []string
+	Receives []string
+
+	StarterPointsBefore float64
+	StarterPointsAfter  float64
+	StarterPointsChange float64
+
+	// bench depth is the projected points of the best few bench players
+	BenchDepthBefore float64
+	BenchDepthAfter  float64
+
+	// weeks where the roster can't fill every starting slot with a player who has a game
+	UncoveredWeeksBefore []int
+	UncoveredWeeksAfter  []int
+}
+
+// TradeEvaluation is the projected rest-of-season impact of a trade on both rosters.
+type TradeEvaluation struct {
+	Weeks []int
+	Sides [2]TradeSide
+	// Lopsided is set when one side comes out well ahead of the other.
+	Lopsided bool
+}
+
+// EvaluateTrade projects how a trade would change both rosters' optimal lineups for the rest of the regular season.
+// playersA are the players rosterA sends to rosterB, and playersB are the players rosterB sends to rosterA.
+func (l League) EvaluateTrade(rosterA int, rosterB int, playersA []string, playersB []string) (TradeEvaluation, error) {
+	eval := TradeEvaluation{}
+	a, ok := l.Rosters[rosterA]
+	if !ok {
+		return eval, fmt.Errorf("unknown roster %d", rosterA)
+	}
+	b, ok := l.Rosters[rosterB]
+	if !ok {
+		return eval, fmt.Errorf("unknown roster %d", rosterB)
+	}
+	if err := checkOwnership(a, playersA); err != nil {
+		return eval, err
+	}
+	if err := checkOwnership(b, playersB); err != nil {
+		return eval, err
+	}
+
+	weeks, err := l.remainingWeeks()
+	if err != nil {
+		return eval, err
+	}
+	eval.Weeks = weeks
+
+	allPlayers := append(append([]string{}, a.Players...), b.Players...)
+	projections := make(map[int]map[string]float64)
+	for _, w := range weeks {
+		points, err := l.projectedPoints(allPlayers, w)
+		if err != nil {
+			return eval, err
+		}
+		projections[w] = points
+	}
+
+	eval.Sides[0] = l.tradeSide(rosterA, a.Players, playersA, playersB, weeks, projections)
+	eval.Sides[1] = l.tradeSide(rosterB, b.Players, playersB, playersA, weeks, projections)
+	eval.Lopsided = math.Abs(eval.Sides[0].StarterPointsChange-eval.Sides[1].StarterPointsChange) >= lopsidedTradeMargin
+
+	return eval, nil
+}
+
+// EvaluateTradeTransaction evaluates a two team trade from the transactions API, e.g. one that's under review.
+func (l League) EvaluateTradeTransaction(tx TransactionJSON) (TradeEvaluation, error) {
+	if tx.Type != "trade" || len(tx.RosterIDs) != 2 {
+		return TradeEvaluation{}, fmt.Errorf("transaction %s is not a two team trade", tx.TransactionID)
+	}
+	rosterA, rosterB := tx.RosterIDs[0], tx.RosterIDs[1]
+	playersA, playersB := make([]string, 0), make([]string, 0)
+	for playerID, to := range tx.Adds {
+		if to == rosterB {
+			playersA = append(playersA, playerID)
+		} else if to == rosterA {
+			playersB = append(playersB, playerID)
+		}
+	}
+	sort.Strings(playersA)
+	sort.Strings(playersB)
+	return l.EvaluateTrade(rosterA, rosterB, playersA, playersB)
+}
+
+func checkOwnership(roster RosterJSON, players []string) error {
+	owned := make(map[string]bool)
+	for _, p := range roster.Players {
+		owned[p] = true
+	}
+	for _, p := range players {
+		if !owned[p] {
+			return fmt.Errorf("player %s is not on roster %d", p, roster.RosterID)
+		}
+	}
+	return nil
+}
+
+func (l League) tradeSide(rosterID int, roster []string, sends []string, receives []string, weeks []int, projections map[int]map[string]float64) TradeSide {
+	side := TradeSide{
+		RosterID: rosterID,
+		Sends:    sends,
+		Receives: receives,
+	}
+
+	sending := make(map[string]bool)
+	for _, p := range sends {
+		sending[p] = true
+	}
+	after := make([]string, 0, len(roster)+len(receives))
+	for _, p := range roster {
+		if !sending[p] {
+			after = append(after, p)
+		}
+	}
+	after = append(after, receives...)
+
+	for _, w := range weeks {
+		starters, bench, uncovered := l.projectedLineup(roster, projections[w])
+		side.StarterPointsBefore += starters
+		side.BenchDepthBefore += bench
+		if uncovered {
+			side.UncoveredWeeksBefore = append(side.UncoveredWeeksBefore, w)
+		}
+
+		starters, bench, uncovered = l.projectedLineup(after, projections[w])
+		side.StarterPointsAfter += starters
+		side.BenchDepthAfter += bench
+		if uncovered {
+			side.UncoveredWeeksAfter = append(side.UncoveredWeeksAfter, w)
+		}
+	}
+
+	if n := float64(len(weeks)); n > 0 {
+		side.StarterPointsBefore /= n
+		side.StarterPointsAfter /= n
+		side.BenchDepthBefore /= n
+		side.BenchDepthAfter /= n
+	}
+	side.StarterPointsChange = side.StarterPointsAfter - side.StarterPointsBefore
+	return side
+}
+
+// projectedLineup returns the projected starter points and bench depth of a set of players for one week, and
+// whether any starting slot is left empty because nobody eligible has a game.
+func (l League) projectedLineup(players []string, projections map[string]float64) (float64, float64, bool) {
+	// players without a projection aren't playing this week
+	available := make([]string, 0, len(players))
+	for _, p := range players {
+		if _, ok := projections[p]; ok {
+			available = append(available, p)
+		}
+	}
+	lineup := l.bestLineup(available, projections)
+
+	uncovered := false
+	starting := make(map[string]bool)
+	for _, s := range lineup.Slots {
+		if s.PlayerID == emptySlot {
+			uncovered = true
+		}
+		starting[s.PlayerID] = true
+	}
+
+	benchPoints := make([]float64, 0)
+	for _, p := range available {
+		if !starting[p] {
+			benchPoints = append(benchPoints, projections[p])
+		}
+	}
+	sort.Sort(sort.Reverse(sort.Float64Slice(benchPoints)))
+	bench := 0.0
+	for i := 0; i < len(benchPoints) && i < benchDepthPlayers; i++ {
+		bench += benchPoints[i]
+	}
+
+	return lineup.Points, bench, uncovered
+}
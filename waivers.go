@@ -0,0 +1,191 @@
+package sleeper
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// most free agents considered by WaiverTargets, by search rank, on top of anyone trending
+const waiverCandidates = 200
+
+// points of ranking score a waiver target gets for every tenfold increase in trending adds
+const trendingWeight = 1.0
+
+// fraction of the remaining budget to bid per unit of relative lineup improvement, and the most we'll ever suggest
+const (
+	faabBidScale    = 5.0
+	maxFAABBidShare = 0.5
+)
+
+// FreeAgentFilter narrows down the free agents returned by FreeAgents. The zero value matches everyone.
+type FreeAgentFilter = PlayerFilter
+
+// WaiverTarget is a free agent who would improve a roster's lineup this week.
+type WaiverTarget struct {
+	PlayerID   string
+	Position   string
+	Team       string
+	Projection float64
+	// Improvement is how many projected points the player adds to the roster's optimal lineup.
+	Improvement  float64
+	TrendingAdds int
+	// Score ranks targets, combining the improvement with how many people are adding the player.
+	Score float64
+	// SuggestedBid is a FAAB bid based on the improvement and the roster's remaining budget.
+	SuggestedBid int
+}
+
+// FreeAgents returns every NFL player who isn't on a roster in the league, including taxi squads and reserve,
+// ordered by search rank.
+func (l League) FreeAgents(filter FreeAgentFilter) []PlayerInfoJSON {
+	rostered := l.rosteredPlayers()
+	freeAgents := make([]PlayerInfoJSON, 0)
+	for id, p := range l.Client.NFLPlayers {
+		if rostered[id] || !filter.matches(p) {
+			continue
+		}
+		if p.PlayerID == "" {
+			p.PlayerID = id
+		}
+		freeAgents = append(freeAgents, p)
+	}
+	sortBySearchRank(freeAgents)
+	return freeAgents
+}
+
+// WaiverTargets ranks free agents by how much they'd improve the given roster's optimal lineup this week, and
+// suggests a FAAB bid for each.
+func (l League) WaiverTargets(rosterID int) ([]WaiverTarget, error) {
+	roster, ok := l.Rosters[rosterID]
+	if !ok {
+		return nil, fmt.Errorf("unknown roster %d", rosterID)
+	}
+
+	state, err := l.Client.GetNflStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	trending, err := l.Client.GetTrendingPlayers(TrendingPlayerTypeAdd)
+	if err != nil {
+		return nil, err
+	}
+	trendingAdds := make(map[string]int)
+	for _, t := range trending {
+		trendingAdds[t.PlayerID] = t.Count
+	}
+
+	// only bother projecting the most relevant free agents at positions the league actually starts
+	positions := make([]string, 0)
+	seenPositions := make(map[string]bool)
+	for _, slot := range l.startingSlots() {
+		eligible, ok := slotEligibility[slot]
+		if !ok {
+			eligible = []string{slot}
+		}
+		for _, p := range eligible {
+			if !seenPositions[p] {
+				seenPositions[p] = true
+				positions = append(positions, p)
+			}
+		}
+	}
+	candidates := make([]string, 0)
+	for i, fa := range l.FreeAgents(FreeAgentFilter{ActiveOnly: true, Positions: positions}) {
+		if stringField(fa.Team) == "" {
+			continue
+		}
+		if i < waiverCandidates || trendingAdds[fa.PlayerID] > 0 {
+			candidates = append(candidates, fa.PlayerID)
+		}
+	}
+
+	projections, err := l.projectedPoints(append(append([]string{}, roster.Players...), candidates...), state.Week)
+	if err != nil {
+		return nil, err
+	}
+	available := make([]string, 0, len(roster.Players))
+	for _, p := range roster.Players {
+		if _, ok := projections[p]; ok {
+			available = append(available, p)
+		}
+	}
+	baseline := l.bestLineup(available, projections).Points
+
+	budgetLeft := l.LeagueInfo.Settings.WaiverBudget - roster.Settings.WaiverBudgetUsed
+	targets := make([]WaiverTarget, 0)
+	for _, c := range candidates {
+		projection, ok := projections[c]
+		if !ok {
+			continue
+		}
+		improvement := l.bestLineup(append(available, c), projections).Points - baseline
+		if improvement <= 0 {
+			continue
+		}
+		targets = append(targets, WaiverTarget{
+			PlayerID:     c,
+			Position:     l.Client.NFLPlayers[c].Position,
+			Team:         l.playerTeam(c),
+			Projection:   projection,
+			Improvement:  improvement,
+			TrendingAdds: trendingAdds[c],
+			Score:        improvement + trendingWeight*math.Log10(1+float64(trendingAdds[c])),
+			SuggestedBid: suggestFAABBid(improvement, baseline, trendingAdds[c], budgetLeft),
+		})
+	}
+
+	sort.Slice(targets, func(i, j int) bool {
+		if targets[i].Score != targets[j].Score {
+			return targets[i].Score > targets[j].Score
+		}
+		return targets[i].PlayerID < targets[j].PlayerID
+	})
+	return targets, nil
+}
+
+// suggestFAABBid bids a share of the remaining budget in proportion to how much the player improves the lineup,
+// bumped up a bit for players lots of people are adding.
+func suggestFAABBid(improvement float64, baseline float64, trendingAdds int, budgetLeft int) int {
+	if budgetLeft <= 0 || baseline <= 0 {
+		return 0
+	}
+	share := math.Min(improvement/baseline*faabBidScale, maxFAABBidShare)
+	demand := 1 + math.Min(math.Log10(1+float64(trendingAdds))/8, 0.25)
+	bid := int(math.Round(float64(budgetLeft) * share * demand))
+	if bid > budgetLeft {
+		bid = budgetLeft
+	}
+	return bid
+}
+
+// rosteredPlayers returns every player on any roster in the league.
+func (l League) rosteredPlayers() map[string]bool {
+	rostered := make(map[string]bool)
+	for _, r := range l.Rosters {
+		for _, group := range [][]string{r.Players, r.Starters, r.Taxi, r.Reserve} {
+			for _, p := range group {
+				rostered[p] = true
+			}
+		}
+	}
+	return rostered
+}
+
+// sortBySearchRank orders players by Sleeper's search rank, with unranked players last.
+func sortBySearchRank(players []PlayerInfoJSON) {
+	rank := func(p PlayerInfoJSON) int {
+		if p.SearchRank <= 0 {
+			return math.MaxInt32
+		}
+		return p.SearchRank
+	}
+	sort.Slice(players, func(i, j int) bool {
+		ri, rj := rank(players[i]), rank(players[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return players[i].PlayerID < players[j].PlayerID
+	})
+}
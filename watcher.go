@@ -0,0 +1,217 @@
+package sleeper
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+const defaultLeaguePollInterval = time.Minute
+
+// LeagueWatcher polls a league and emits events for transactions, lineup changes and scoring.
+type LeagueWatcher struct {
+	League League
+	// Interval is how often to poll. Defaults to a minute.
+	Interval time.Duration
+	// ErrorHandler is called with any error hit while polling. Polling carries on at the next interval either way.
+	ErrorHandler func(error)
+
+	emitter
+
+	// state from the last poll
+	week             int
+	rosters          map[int]RosterJSON
+	matchups         map[int]MatchupJSON
+	seenTransactions map[string]bool
+}
+
+// NewLeagueWatcher creates a watcher that polls the given league at the given interval.
+func NewLeagueWatcher(l League, interval time.Duration) *LeagueWatcher {
+	return &LeagueWatcher{
+		League:           l,
+		Interval:         interval,
+		seenTransactions: make(map[string]bool),
+	}
+}
+
+// Run polls the league until the context is cancelled. The first poll only records the league's current state,
+// so events are only emitted for changes after Run is called.
+func (w *LeagueWatcher) Run(ctx context.Context) error {
+	defer w.close()
+
+	// until a poll succeeds there's nothing to compare against, so everything would look new
+	notify := false
+	poll := func() {
+		if err := w.poll(ctx, notify); err != nil {
+			w.handleError(err)
+		} else {
+			notify = true
+		}
+	}
+
+	poll()
+	ticker := time.NewTicker(w.pollInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			poll()
+		}
+	}
+}
+
+func (w *LeagueWatcher) pollInterval() time.Duration {
+	if w.Interval <= 0 {
+		return defaultLeaguePollInterval
+	}
+	return w.Interval
+}
+
+func (w *LeagueWatcher) handleError(err error) {
+	if w.ErrorHandler != nil {
+		w.ErrorHandler(err)
+	}
+}
+
+func (w *LeagueWatcher) poll(ctx context.Context, notify bool) error {
+	c := w.League.Client
+	leagueID := w.League.ID
+
+	state, err := c.GetNflStatus()
+	if err != nil {
+		return err
+	}
+
+	rosters, err := c.GetLeagueRosters(leagueID)
+	if err != nil {
+		return err
+	}
+	rosterMap := make(map[int]RosterJSON)
+	for _, r := range rosters {
+		rosterMap[r.RosterID] = r
+		previous, ok := w.rosters[r.RosterID]
+		if !ok || !notify {
+			continue
+		}
+		added, removed := diffRoster(previous.Players, r.Players)
+		if len(added) > 0 || len(removed) > 0 {
+			w.emit(ctx, RosterChanged{LeagueID: leagueID, RosterID: r.RosterID, Added: added, Removed: removed})
+		}
+	}
+	w.rosters = rosterMap
+	w.League.Rosters = rosterMap
+
+	if state.Week != w.week {
+		// the previous week is over, so refetch it to pick up any stat corrections in the final scores
+		if notify && w.week >= 1 {
+			lastWeek, err := c.GetLeagueMatchups(leagueID, w.week)
+			if err != nil {
+				return err
+			}
+			for _, pair := range pairMatchups(lastWeek) {
+				w.emit(ctx, MatchupFinal{LeagueID: leagueID, Week: w.week, MatchupID: pair[0].MatchupID, Teams: pair})
+			}
+		}
+		w.week = state.Week
+		w.matchups = nil
+	}
+	if w.week < 1 {
+		return nil
+	}
+
+	transactions, err := c.GetLeagueTransactions(leagueID, w.week)
+	if err != nil {
+		return err
+	}
+	for _, tx := range transactions {
+		if w.seenTransactions[tx.TransactionID] || (tx.Status != "complete" && tx.Status != "failed") {
+			continue
+		}
+		w.seenTransactions[tx.TransactionID] = true
+		if !notify {
+			continue
+		}
+		switch {
+		case tx.Type == "trade" && tx.Status == "complete":
+			w.emit(ctx, TradeCompleted{LeagueID: leagueID, Transaction: tx})
+		case tx.Type == "waiver":
+			w.emit(ctx, WaiverProcessed{LeagueID: leagueID, Transaction: tx})
+		case tx.Type == "free_agent" && tx.Status == "complete":
+			w.emit(ctx, FreeAgentAdded{LeagueID: leagueID, Transaction: tx})
+		}
+	}
+
+	matchups, err := c.GetLeagueMatchups(leagueID, w.week)
+	if err != nil {
+		return err
+	}
+	current := make(map[int]MatchupJSON)
+	for _, m := range matchups {
+		current[m.RosterID] = m
+		previous, ok := w.matchups[m.RosterID]
+		if !ok || !notify {
+			continue
+		}
+		if !sameStarters(previous.Starters, m.Starters) {
+			w.emit(ctx, LineupChanged{
+				LeagueID: leagueID,
+				Week:     w.week,
+				RosterID: m.RosterID,
+				Before:   previous.Starters,
+				After:    m.Starters,
+			})
+		}
+		if previous.Points != m.Points {
+			w.emit(ctx, ScoreUpdated{
+				LeagueID:       leagueID,
+				Week:           w.week,
+				MatchupID:      m.MatchupID,
+				RosterID:       m.RosterID,
+				PreviousPoints: float64(previous.Points),
+				Points:         float64(m.Points),
+			})
+		}
+	}
+	w.matchups = current
+
+	return nil
+}
+
+func sameStarters(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// diffRoster returns the players in after but not before, and in before but not after, each sorted by ID.
+func diffRoster(before []string, after []string) ([]string, []string) {
+	inBefore := make(map[string]bool)
+	for _, p := range before {
+		inBefore[p] = true
+	}
+	inAfter := make(map[string]bool)
+	added := make([]string, 0)
+	for _, p := range after {
+		inAfter[p] = true
+		if !inBefore[p] {
+			added = append(added, p)
+		}
+	}
+	removed := make([]string, 0)
+	for _, p := range before {
+		if !inAfter[p] {
+			removed = append(removed, p)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
@@ -0,0 +1,95 @@
+package sleeper
+
+import "math"
+
+// standard deviation of a player's final score at kickoff, as a fraction of their projection
+const playerStdDevRatio = 0.5
+
+// floor on a player's kickoff standard deviation so low projections still carry some uncertainty
+const minPlayerStdDev = 2.0
+
+// TeamWinProbability is one side of a matchup's projected outcome.
+type TeamWinProbability struct {
+	RosterID   int
+	Projection float64
+	// StdDev is the standard deviation of the team's final score given the time left in its players' games.
+	StdDev         float64
+	WinProbability float64
+}
+
+// MatchupWinProbability is the projected outcome of a single head-to-head matchup.
+type MatchupWinProbability struct {
+	MatchupID int
+	Teams     [2]TeamWinProbability
+	// ProjectedMargin is the first team's projection minus the second team's.
+	ProjectedMargin float64
+}
+
+// MatchupWinProbabilities projects every matchup for the given week and estimates each side's chance of winning.
+// Each starter's score is treated as normally distributed around their live projection, with the spread shrinking
+// as their game clock runs down. Live projections come from the given model, the same as GetProjections, and a nil
+// model uses SleeperProjectionModel.
+func (l League) MatchupWinProbabilities(week int, model ProjectionModel) ([]MatchupWinProbability, error) {
+	if model == nil {
+		model = SleeperProjectionModel{}
+	}
+
+	matchups, inputs, err := l.projectionInputs(week)
+	if err != nil {
+		return nil, err
+	}
+
+	probabilities := make([]MatchupWinProbability, 0)
+	for _, pair := range pairMatchups(matchups) {
+		mwp := MatchupWinProbability{MatchupID: pair[0].MatchupID}
+		variances := [2]float64{}
+		for i, m := range pair {
+			mwp.Teams[i].RosterID = m.RosterID
+			for _, p := range m.Starters {
+				in, ok := inputs[p]
+				if !ok {
+					continue
+				}
+				mwp.Teams[i].Projection += model.ProjectPlayer(in)
+				variances[i] += playerVariance(in)
+			}
+			mwp.Teams[i].StdDev = math.Sqrt(variances[i])
+		}
+
+		mwp.ProjectedMargin = mwp.Teams[0].Projection - mwp.Teams[1].Projection
+		totalStdDev := math.Sqrt(variances[0] + variances[1])
+		switch {
+		case totalStdDev > 0:
+			mwp.Teams[0].WinProbability = normalCDF(mwp.ProjectedMargin / totalStdDev)
+		case mwp.ProjectedMargin > 0:
+			mwp.Teams[0].WinProbability = 1
+		case mwp.ProjectedMargin < 0:
+			mwp.Teams[0].WinProbability = 0
+		default:
+			mwp.Teams[0].WinProbability = 0.5
+		}
+		mwp.Teams[1].WinProbability = 1 - mwp.Teams[0].WinProbability
+
+		probabilities = append(probabilities, mwp)
+	}
+	return probabilities, nil
+}
+
+// playerVariance is the variance of a player's remaining scoring, which scales with the share of the game left.
+func playerVariance(in ProjectionInput) float64 {
+	var fractionLeft float64
+	switch in.GameStatus {
+	case "pre_game":
+		fractionLeft = 1
+	case "complete":
+		return 0
+	default:
+		fractionLeft = in.fractionLeft()
+	}
+	stdDev := math.Max(playerStdDevRatio*in.Projected, minPlayerStdDev)
+	return stdDev * stdDev * fractionLeft
+}
+
+func normalCDF(z float64) float64 {
+	return 0.5 * (1 + math.Erf(z/math.Sqrt2))
+}